@@ -0,0 +1,51 @@
+# Example: Assert that the latest backup of each production database succeeded in the past 24 hours
+data "eon_inventory_resources" "prod_databases" {
+  resource_type = {
+    operator       = "IN"
+    resource_types = ["AWS_RDS"]
+  }
+
+  environment = {
+    operator     = "IN"
+    environments = ["PROD"]
+  }
+}
+
+check "prod_databases_backed_up" {
+  data "eon_backup_jobs" "recent" {
+    resource_ids  = data.eon_inventory_resources.prod_databases.resources[*].id
+    started_after = timeadd(plantimestamp(), "-24h")
+  }
+
+  assert {
+    condition = alltrue([
+      for resource in data.eon_inventory_resources.prod_databases.resources :
+      try([for job in data.eon_backup_jobs.recent.jobs : job.status if job.resource_id == resource.id][0], "") == "JOB_COMPLETED"
+    ])
+    error_message = "At least one production database has no successful backup in the past 24 hours."
+  }
+}
+
+# Example: List failed backups of resources covered by a backup policy
+data "eon_backup_jobs" "failed" {
+  backup_policy_id = eon_backup_policy.production.id
+  statuses         = ["JOB_FAILED", "JOB_PARTIAL"]
+  started_after    = "2024-01-01T00:00:00Z"
+}
+
+output "failed_backup_messages" {
+  value = {
+    for job in data.eon_backup_jobs.failed.jobs :
+    job.job_id => job.status_message
+  }
+}
+
+# Example: Read only the latest backup job of a resource
+data "eon_backup_jobs" "latest" {
+  resource_ids = [data.eon_inventory_resource.orders_db.id]
+  max_results  = 1
+}
+
+output "latest_backup_status" {
+  value = try(data.eon_backup_jobs.latest.jobs[0].status, null)
+}
@@ -0,0 +1,45 @@
+# Example: Preview a conditional selector before applying it to a policy
+data "eon_backup_policy_preview" "production" {
+  resource_selector = {
+    resource_selection_mode = "CONDITIONAL"
+    expression = {
+      group = {
+        operator = "AND"
+        operands = [
+          {
+            environment = {
+              operator     = "IN"
+              environments = ["PROD"]
+            }
+          },
+          {
+            tag_key_values = {
+              operator = "CONTAINS_ANY_OF"
+              tag_key_values = [
+                {
+                  key   = "backup"
+                  value = "required"
+                }
+              ]
+            }
+          }
+        ]
+      }
+    }
+  }
+
+  # Compare against the policy this selector is replacing
+  compare_policy_id = "your-existing-policy-id"
+}
+
+output "matched_by_type" {
+  value = data.eon_backup_policy_preview.production.count_by_resource_type
+}
+
+# Fail the plan if the new selector would stop protecting any resource
+check "no_coverage_lost" {
+  assert {
+    condition     = length(data.eon_backup_policy_preview.production.resources_losing_coverage) == 0
+    error_message = "The new selector stops protecting: ${join(", ", data.eon_backup_policy_preview.production.resources_losing_coverage[*].name)}"
+  }
+}
@@ -0,0 +1,23 @@
+data "eon_inventory_resource" "orders_db" {
+  id = "a1b2c3d4-5678-90ab-cdef-1234567890ab"
+}
+
+# Example: Restore the latest snapshot of the resource
+resource "eon_restore_job" "orders_db_restore" {
+  snapshot_id        = data.eon_inventory_resource.orders_db.latest_snapshot_id
+  restore_account_id = "your-restore-account-id"
+  restore_type       = "full"
+
+  rds_config {
+    region                 = data.eon_inventory_resource.orders_db.region
+    db_instance_identifier = "orders-db-restored"
+  }
+}
+
+output "orders_db_info" {
+  value = {
+    name          = data.eon_inventory_resource.orders_db.name
+    backup_status = data.eon_inventory_resource.orders_db.backup_status
+    latest_backup = data.eon_inventory_resource.orders_db.latest_snapshot_time
+  }
+}
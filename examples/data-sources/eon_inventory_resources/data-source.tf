@@ -0,0 +1,77 @@
+# Example: List production RDS instances in us-east-1
+data "eon_inventory_resources" "prod_databases" {
+  resource_type = {
+    operator       = "IN"
+    resource_types = ["AWS_RDS"]
+  }
+
+  environment = {
+    operator     = "IN"
+    environments = ["PROD"]
+  }
+
+  source_region = {
+    operator       = "IN"
+    source_regions = ["us-east-1"]
+  }
+
+  include_latest_snapshot = true
+}
+
+# Example: Find resources tagged for backup that aren't protected yet
+data "eon_inventory_resources" "tagged_for_backup" {
+  tag_key_values = {
+    operator = "CONTAINS_ANY_OF"
+    tag_key_values = [
+      {
+        key   = "backup"
+        value = "required"
+      }
+    ]
+  }
+}
+
+locals {
+  unprotected_resources = [
+    for resource in data.eon_inventory_resources.tagged_for_backup.resources :
+    resource.id if resource.backup_status == "NOT_BACKED_UP"
+  ]
+}
+
+# Example: Include the unprotected resources in a backup policy
+resource "eon_backup_policy" "tagged_resources" {
+  name    = "Tagged Resources"
+  enabled = true
+
+  resource_selector = {
+    resource_selection_mode     = "NONE"
+    resource_inclusion_override = local.unprotected_resources
+  }
+
+  backup_plan = {
+    backup_policy_type = "STANDARD"
+    standard_plan = {
+      backup_schedules = [
+        {
+          vault_id       = "your-vault-id"
+          retention_days = 30
+          schedule_config = {
+            frequency = "DAILY"
+            daily_config = {
+              time_of_day_hour     = 2
+              time_of_day_minutes  = 0
+              start_window_minutes = 240
+            }
+          }
+        }
+      ]
+    }
+  }
+}
+
+output "prod_database_latest_snapshots" {
+  value = {
+    for resource in data.eon_inventory_resources.prod_databases.resources :
+    resource.name => resource.latest_snapshot_id
+  }
+}
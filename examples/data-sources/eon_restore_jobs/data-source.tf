@@ -0,0 +1,21 @@
+# Example: List RDS restores from the past week
+data "eon_restore_jobs" "recent_rds_restores" {
+  restore_types = ["AWS_RDS_INSTANCE_RESTORE"]
+  started_after = timeadd(plantimestamp(), "-168h")
+}
+
+output "recent_rds_restores" {
+  value = [
+    for job in data.eon_restore_jobs.recent_rds_restores.jobs : {
+      job_id        = job.job_id
+      status        = job.status
+      resource_name = job.resource_name
+      duration      = job.duration_seconds
+    }
+  ]
+}
+
+# Example: Find restores that are still running
+data "eon_restore_jobs" "running" {
+  statuses = ["JOB_PENDING", "JOB_RUNNING"]
+}
@@ -0,0 +1,30 @@
+resource "eon_ebs_volume_restore" "data_volume" {
+  snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
+  restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  wait_for_completion = true
+
+  provider_volume_id       = "vol-0f55f55a02e069c53"
+  availability_zone        = "us-east-1a"
+  volume_type              = "gp3"
+  volume_size              = "100GiB"
+  volume_encryption_key_id = "alias/aws/ebs"
+
+  tags = {
+    Name = "eon-restored-volume"
+  }
+
+  timeouts {
+    create = "120m"
+  }
+}
+
+# Move an existing eon_restore_job that uses ebs_config to this resource type
+# without starting a new restore job.
+moved {
+  from = eon_restore_job.ebs_volume
+  to   = eon_ebs_volume_restore.data_volume
+}
+
+output "restored_volume_id" {
+  value = eon_ebs_volume_restore.data_volume.restored_volume_id
+}
@@ -0,0 +1,31 @@
+resource "eon_ec2_instance_restore" "web_server" {
+  snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
+  restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  wait_for_completion = true
+
+  region        = "us-east-1"
+  instance_type = "t3.medium"
+  subnet_id     = "subnet-0123456789abcdef0"
+  security_group_ids = [
+    "sg-0123456789abcdef0",
+  ]
+
+  tags = {
+    Name = "eon-restored-instance"
+  }
+
+  volume_restore_params {
+    provider_volume_id = "vol-0f55f55a02e069c53"
+    volume_type        = "gp3"
+    volume_size        = "20GiB"
+    description        = "Root volume"
+  }
+
+  timeouts {
+    create = "120m"
+  }
+}
+
+output "restored_instance_id" {
+  value = eon_ec2_instance_restore.web_server.restored_instance_id
+}
@@ -0,0 +1,22 @@
+resource "eon_rds_instance_restore" "orders_db" {
+  resource_id         = "b7c2a5e4-3f1d-4c8e-9a6b-2d4f8e1c7a90"
+  point_in_time       = "2024-06-01T12:00:00Z"
+  restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  wait_for_completion = true
+
+  db_instance_identifier = "orders-restored"
+  region                 = "us-east-1"
+  subnet_group_name      = "default"
+  vpc_security_group_ids = [
+    "sg-0123456789abcdef0",
+  ]
+  kms_key_id = "alias/aws/rds"
+
+  tags = {
+    Name = "eon-restored-database"
+  }
+
+  timeouts {
+    create = "180m"
+  }
+}
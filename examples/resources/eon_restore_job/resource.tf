@@ -3,14 +3,17 @@ resource "eon_restore_job" "ebs_volume" {
   restore_type        = "partial"
   snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
   restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
-  timeout_minutes     = 120
   wait_for_completion = true
 
+  timeouts {
+    create = "120m"
+  }
+
   ebs_config {
     provider_volume_id       = "vol-0f55f55a02e069c53"
     availability_zone        = "us-east-1a"
     volume_type              = "gp3"
-    volume_size              = 100
+    volume_size              = "100GiB"
     description              = "Test EBS volume restore from Eon using new resource"
     volume_encryption_key_id = "alias/aws/ebs"
 
@@ -26,9 +29,12 @@ resource "eon_restore_job" "ec2_instance" {
   restore_type        = "full"
   snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
   restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
-  timeout_minutes     = 120
   wait_for_completion = true
 
+  timeouts {
+    create = "120m"
+  }
+
   ec2_config {
     region        = "us-east-1"
     instance_type = "t3.medium"
@@ -47,7 +53,7 @@ resource "eon_restore_job" "ec2_instance" {
     volume_restore_params {
       provider_volume_id = "vol-0f55f55a02e069c53"
       volume_type        = "gp3"
-      volume_size        = 20
+      volume_size        = "20GiB"
       iops               = 3000
       description        = "Root volume"
       kms_key_id         = "arn:aws:kms:us-east-1:851725316996:key/20c82703-ea74-45f9-a38c-0c142023d694"
@@ -62,25 +68,20 @@ resource "eon_restore_job" "rds_database" {
   restore_type        = "full"
   snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
   restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
-  timeout_minutes     = 180
   wait_for_completion = true
 
+  timeouts {
+    create = "180m"
+  }
+
   rds_config {
     db_instance_identifier = "eon-restored-db"
-    db_instance_class      = "db.t3.micro"
-    engine                 = "mysql"
     region                 = "us-east-1"
     subnet_group_name      = "default"
     vpc_security_group_ids = [
       "sg-0123456789abcdef0"
     ]
-    allocated_storage       = 20
-    storage_type            = "gp2"
-    backup_retention_period = 7
-    multi_az                = false
-    publicly_accessible     = false
-    storage_encrypted       = true
-    kms_key_id              = "alias/aws/rds"
+    kms_key_id = "alias/aws/rds"
 
     tags = {
       Name        = "eon-restored-database"
@@ -95,9 +96,12 @@ resource "eon_restore_job" "s3_bucket" {
   restore_type        = "full"
   snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
   restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
-  timeout_minutes     = 90
   wait_for_completion = true
 
+  timeouts {
+    create = "90m"
+  }
+
   s3_bucket_config {
     bucket_name = "my-bucket"
     key_prefix  = "restored-data/"
@@ -109,9 +113,12 @@ resource "eon_restore_job" "s3_files" {
   restore_type        = "partial"
   snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
   restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
-  timeout_minutes     = 60
   wait_for_completion = true
 
+  timeouts {
+    create = "60m"
+  }
+
   s3_file_config {
     bucket_name = "my-bucket"
     key_prefix  = "restored-files/"
@@ -128,22 +135,97 @@ resource "eon_restore_job" "s3_files" {
   }
 }
 
+# 6. DynamoDB Table Restore
+resource "eon_restore_job" "dynamodb_table" {
+  restore_type        = "full"
+  snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
+  restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  wait_for_completion = true
+
+  dynamodb_config {
+    table_name           = "orders-restored"
+    region               = "us-east-1"
+    kms_key_id           = "alias/aws/dynamodb"
+    write_capacity_units = 10
+
+    tags = {
+      Name = "eon-dynamodb-restore"
+    }
+  }
+}
+
+# 7. Azure Blob Storage Restore
+# The restore account must be an Azure subscription.
+resource "eon_restore_job" "azure_blob" {
+  restore_type        = "full"
+  snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
+  restore_account_id  = "3c1f9b2e-7d4a-4e8f-b5c6-9a0d1e2f3b4c"
+  wait_for_completion = true
+
+  azure_blob_config {
+    storage_account_name = "restoredata"
+    resource_group       = "dr-resources"
+    container            = "restored"
+    prefix               = "blob-restore/"
+  }
+}
+
+# 8. Recurring DR Drill
+# Changing the triggers map starts a new restore job, for example from a nightly CI run
+# that passes -var="drill_id=$(date +%F)".
+variable "drill_id" {
+  type    = string
+  default = "initial"
+}
+
+resource "eon_restore_job" "dr_drill" {
+  restore_type        = "full"
+  snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
+  restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  wait_for_completion = true
+
+  triggers = {
+    drill_id = var.drill_id
+  }
+
+  s3_bucket_config {
+    bucket_name = "my-dr-drill-bucket"
+    key_prefix  = "drill/${var.drill_id}/"
+  }
+}
+
+# 9. Point-in-Time Restore
+# Restores from the latest snapshot of the resource taken at or before point_in_time.
+resource "eon_restore_job" "point_in_time" {
+  restore_type        = "full"
+  resource_id         = "b7c2a5e4-3f1d-4c8e-9a6b-2d4f8e1c7a90"
+  point_in_time       = "2024-06-01T12:00:00Z"
+  restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  wait_for_completion = true
+
+  s3_bucket_config {
+    bucket_name = "my-bucket"
+    key_prefix  = "restored-pitr/"
+  }
+}
 
 output "ebs_restore_info" {
   value = {
-    job_id       = eon_restore_job.ebs_volume.job_id
-    status       = eon_restore_job.ebs_volume.status
-    created_at   = eon_restore_job.ebs_volume.created_at
-    completed_at = eon_restore_job.ebs_volume.completed_at
+    job_id             = eon_restore_job.ebs_volume.job_id
+    status             = eon_restore_job.ebs_volume.status
+    created_at         = eon_restore_job.ebs_volume.created_at
+    completed_at       = eon_restore_job.ebs_volume.completed_at
+    restored_volume_id = eon_restore_job.ebs_volume.restored_volume_id
   }
 }
 
 output "ec2_restore_info" {
   value = {
-    job_id       = eon_restore_job.ec2_instance.job_id
-    status       = eon_restore_job.ec2_instance.status
-    created_at   = eon_restore_job.ec2_instance.created_at
-    completed_at = eon_restore_job.ec2_instance.completed_at
+    job_id               = eon_restore_job.ec2_instance.job_id
+    status               = eon_restore_job.ec2_instance.status
+    created_at           = eon_restore_job.ec2_instance.created_at
+    completed_at         = eon_restore_job.ec2_instance.completed_at
+    restored_instance_id = eon_restore_job.ec2_instance.restored_instance_id
   }
 }
 
@@ -0,0 +1,86 @@
+# Example: DR drill restoring every production EC2 instance and RDS database
+# to one point in time
+resource "eon_restore_plan" "dr_drill" {
+  restore_account_id = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  point_in_time      = "2024-06-01T00:00:00Z"
+  concurrency        = 10
+
+  resource_selector = {
+    resource_selection_mode = "CONDITIONAL"
+
+    expression = {
+      group = {
+        operator = "AND"
+        operands = [
+          {
+            resource_type = {
+              operator       = "IN"
+              resource_types = ["AWS_EC2", "AWS_RDS"]
+            }
+          },
+          {
+            environment = {
+              operator     = "IN"
+              environments = ["PROD"]
+            }
+          }
+        ]
+      }
+    }
+  }
+
+  ec2_defaults {
+    region             = "us-west-2"
+    instance_type      = "t3.medium"
+    subnet_id          = "subnet-0123456789abcdef0"
+    security_group_ids = ["sg-0123456789abcdef0"]
+    kms_key_id         = "alias/aws/ebs"
+
+    tags = {
+      DrDrill = "true"
+    }
+  }
+
+  rds_defaults {
+    region            = "us-west-2"
+    kms_key_id        = "alias/aws/rds"
+    subnet_group_name = "dr-drill-subnet-group"
+    name_suffix       = "-drill"
+  }
+
+  timeouts {
+    create = "4h"
+  }
+}
+
+# Example: Restore specific buckets and tables from their latest snapshots
+resource "eon_restore_plan" "data_stores" {
+  restore_account_id = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  resource_ids = [
+    "7c1e5b0a-3f5e-4f43-9c39-0d8a2f1b6c11",
+    "0b7d2c43-8a21-4d1e-b2f4-5e6a7c8d9e02",
+  ]
+
+  s3_bucket_defaults {
+    bucket_name = "my-dr-restore-bucket"
+    key_prefix  = "restored/"
+  }
+
+  dynamodb_defaults {
+    region     = "us-east-1"
+    kms_key_id = "alias/aws/dynamodb"
+  }
+}
+
+output "dr_drill_status" {
+  value = eon_restore_plan.dr_drill.status
+}
+
+output "dr_drill_restored_instances" {
+  value = flatten([
+    for restore in eon_restore_plan.dr_drill.restores : [
+      for restored in restore.restored_resources : restored.provider_resource_id
+      if restored.resource_type == "AWS_EC2_INSTANCE"
+    ]
+  ])
+}
@@ -0,0 +1,19 @@
+variable "drill_id" {
+  type    = string
+  default = "initial"
+}
+
+# Changing the triggers map starts a new restore job, for example from a nightly
+# disaster recovery drill.
+resource "eon_s3_bucket_restore" "dr_drill" {
+  snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
+  restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  wait_for_completion = true
+
+  bucket_name = "my-dr-drill-bucket"
+  key_prefix  = "drill/${var.drill_id}/"
+
+  triggers = {
+    drill_id = var.drill_id
+  }
+}
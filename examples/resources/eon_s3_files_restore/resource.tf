@@ -0,0 +1,18 @@
+resource "eon_s3_files_restore" "config_files" {
+  snapshot_id         = "cd6312c7-0713-4a24-a0b3-b838c1108d2f"
+  restore_account_id  = "e696c7f0-17c6-4d9b-b589-591293c00d36"
+  wait_for_completion = true
+
+  bucket_name = "my-bucket"
+  key_prefix  = "restored-files/"
+
+  files {
+    path         = "config/app.yml"
+    is_directory = false
+  }
+
+  files {
+    path         = "assets"
+    is_directory = true
+  }
+}
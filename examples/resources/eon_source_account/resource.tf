@@ -6,12 +6,14 @@ resource "eon_source_account" "aws_production" {
   role                = "arn:aws:iam::123456789012:role/EonBackupRole"
 }
 
-# Example: Connect an AWS source account
+# Example: Connect an AWS source account and publish its job metrics to CloudWatch
 resource "eon_source_account" "aws_staging" {
   name                = "Staging AWS Account"
   cloud_provider      = "AWS"
   provider_account_id = "987654321098"
   role                = "arn:aws:iam::987654321098:role/EonBackupRole"
+  metrics_enabled     = true
+  metrics_region      = "us-east-1"
 }
 
 # Output the account details
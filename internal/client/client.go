@@ -2,14 +2,22 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"time"
 
 	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// ErrRestoreJobWaitTimeout is returned when a restore job doesn't finish within the wait timeout
+var ErrRestoreJobWaitTimeout = errors.New("timeout waiting for restore job to complete")
+
+// ErrNotFound is returned when a requested object doesn't exist in the Eon project
+var ErrNotFound = errors.New("not found")
+
 // EonClient wraps the Eon SDK client with authentication and configuration
 type EonClient struct {
 	client       *externalEonSdkAPI.APIClient
@@ -19,6 +27,9 @@ type EonClient struct {
 	clientID     string
 	clientSecret string
 	endpoint     string
+
+	// PollConfig controls how often long-running jobs are polled while waiting for them
+	PollConfig PollConfig
 }
 
 // NewEonClient creates a new Eon API client with the provided configuration
@@ -36,6 +47,7 @@ func NewEonClient(endpoint, clientID, clientSecret, projectID string) (*EonClien
 		clientID:     clientID,
 		clientSecret: clientSecret,
 		endpoint:     endpoint,
+		PollConfig:   DefaultPollConfig,
 	}
 
 	if err := client.authenticate(); err != nil {
@@ -141,6 +153,74 @@ func (c *EonClient) ListRestoreAccounts(ctx context.Context) ([]externalEonSdkAP
 	return resp.GetAccounts(), nil
 }
 
+// GetSourceAccount retrieves a source account by ID. The Accounts API has no get endpoint, so this lists
+// accounts filtered by ID and returns ErrNotFound if none match.
+func (c *EonClient) GetSourceAccount(ctx context.Context, accountId string) (*externalEonSdkAPI.SourceAccount, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	listReq := externalEonSdkAPI.ListSourceAccountsRequest{
+		Filters: &externalEonSdkAPI.AccountsFilterConditions{
+			Id: &externalEonSdkAPI.IdFilters{In: []string{accountId}},
+		},
+	}
+
+	resp, httpResp, err := c.client.AccountsAPI.ListSourceAccounts(ctx, c.ProjectID).ListSourceAccountsRequest(listReq).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to get source account"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(httpResp.Body)
+		return nil, fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	for _, account := range resp.GetAccounts() {
+		if account.Id == accountId {
+			return &account, nil
+		}
+	}
+
+	return nil, fmt.Errorf("source account %s %w", accountId, ErrNotFound)
+}
+
+// GetRestoreAccount retrieves a restore account by ID. The Accounts API has no get endpoint, so this lists
+// accounts filtered by ID and returns ErrNotFound if none match.
+func (c *EonClient) GetRestoreAccount(ctx context.Context, accountId string) (*externalEonSdkAPI.RestoreAccount, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	listReq := externalEonSdkAPI.ListRestoreAccountsRequest{
+		Filters: &externalEonSdkAPI.AccountsFilterConditions{
+			Id: &externalEonSdkAPI.IdFilters{In: []string{accountId}},
+		},
+	}
+
+	resp, httpResp, err := c.client.AccountsAPI.ListRestoreAccounts(ctx, c.ProjectID).ListRestoreAccountsRequest(listReq).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to get restore account"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(httpResp.Body)
+		return nil, fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	for _, account := range resp.GetAccounts() {
+		if account.Id == accountId {
+			return &account, nil
+		}
+	}
+
+	return nil, fmt.Errorf("restore account %s %w", accountId, ErrNotFound)
+}
+
 // ConnectSourceAccount connects a new source account
 func (c *EonClient) ConnectSourceAccount(ctx context.Context, req externalEonSdkAPI.ConnectSourceAccountRequest) (*externalEonSdkAPI.SourceAccount, error) {
 	if err := c.ensureValidToken(); err != nil {
@@ -223,6 +303,140 @@ func (c *EonClient) DisconnectRestoreAccount(ctx context.Context, accountId stri
 	return nil
 }
 
+// GetSourceAccountMetricsConfig retrieves the job metrics settings of a source account
+func (c *EonClient) GetSourceAccountMetricsConfig(ctx context.Context, accountId string) (*externalEonSdkAPI.SourceAccountMetricsConfig, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	resp, httpResp, err := c.client.AccountsAPI.GetSourceAccountMetricsConfig(ctx, accountId, c.ProjectID).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to get source account metrics config"); apiErr != nil {
+		return nil, apiErr
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(httpResp.Body)
+		return nil, fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	config := resp.GetSourceAccountConfig()
+	return &config, nil
+}
+
+// EnableSourceAccountMetrics publishes the job metrics of a source account to CloudWatch. An empty region
+// leaves the choice of region to Eon.
+func (c *EonClient) EnableSourceAccountMetrics(ctx context.Context, accountId, region string) error {
+	if err := c.ensureValidToken(); err != nil {
+		return fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	req := externalEonSdkAPI.EnableSourceAccountMetricsConfigRequest{}
+	if region != "" {
+		req.SetAws(externalEonSdkAPI.AwsAccountMetricsDestination{Region: &region})
+	}
+
+	_, httpResp, err := c.client.AccountsAPI.EnableSourceAccountMetricsConfig(ctx, accountId, c.ProjectID).EnableSourceAccountMetricsConfigRequest(req).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to enable source account metrics"); apiErr != nil {
+		return apiErr
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DisableSourceAccountMetrics stops publishing the job metrics of a source account
+func (c *EonClient) DisableSourceAccountMetrics(ctx context.Context, accountId string) error {
+	if err := c.ensureValidToken(); err != nil {
+		return fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	httpResp, err := c.client.AccountsAPI.DisableSourceAccountMetricsConfig(ctx, accountId, c.ProjectID).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to disable source account metrics"); apiErr != nil {
+		return apiErr
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// GetRestoreAccountMetricsConfig retrieves the job metrics settings of a restore account
+func (c *EonClient) GetRestoreAccountMetricsConfig(ctx context.Context, accountId string) (*externalEonSdkAPI.RestoreAccountMetricsConfig, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	resp, httpResp, err := c.client.AccountsAPI.GetRestoreAccountMetricsConfig(ctx, accountId, c.ProjectID).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to get restore account metrics config"); apiErr != nil {
+		return nil, apiErr
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(httpResp.Body)
+		return nil, fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	config := resp.GetRestoreAccountConfig()
+	return &config, nil
+}
+
+// EnableRestoreAccountMetrics publishes the job metrics of a restore account to CloudWatch. An empty region
+// leaves the choice of region to Eon.
+func (c *EonClient) EnableRestoreAccountMetrics(ctx context.Context, accountId, region string) error {
+	if err := c.ensureValidToken(); err != nil {
+		return fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	req := externalEonSdkAPI.EnableRestoreAccountMetricsConfigRequest{}
+	if region != "" {
+		req.SetAws(externalEonSdkAPI.AwsAccountMetricsDestination{Region: &region})
+	}
+
+	_, httpResp, err := c.client.AccountsAPI.EnableRestoreAccountMetricsConfig(ctx, accountId, c.ProjectID).EnableRestoreAccountMetricsConfigRequest(req).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to enable restore account metrics"); apiErr != nil {
+		return apiErr
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+// DisableRestoreAccountMetrics stops publishing the job metrics of a restore account
+func (c *EonClient) DisableRestoreAccountMetrics(ctx context.Context, accountId string) error {
+	if err := c.ensureValidToken(); err != nil {
+		return fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	httpResp, err := c.client.AccountsAPI.DisableRestoreAccountMetricsConfig(ctx, accountId, c.ProjectID).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to disable restore account metrics"); apiErr != nil {
+		return apiErr
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK && httpResp.StatusCode != http.StatusNoContent {
+		body, _ := io.ReadAll(httpResp.Body)
+		return fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
 // GetRestoreJob retrieves a restore job by ID
 func (c *EonClient) GetRestoreJob(ctx context.Context, jobId string) (*externalEonSdkAPI.RestoreJob, error) {
 	if err := c.ensureValidToken(); err != nil {
@@ -245,6 +459,112 @@ func (c *EonClient) GetRestoreJob(ctx context.Context, jobId string) (*externalE
 	return &job, nil
 }
 
+// ListRestoreJobs retrieves the restore jobs matching the given filters, newest first. Jobs for which match
+// returns false are skipped. If maxResults is positive, paging stops once that many jobs have been found.
+func (c *EonClient) ListRestoreJobs(ctx context.Context, filters *externalEonSdkAPI.RestoreJobsFilterConditions, maxResults int, match func(*externalEonSdkAPI.RestoreJob) bool) ([]externalEonSdkAPI.RestoreJob, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	listReq := externalEonSdkAPI.ListRestoreJobsRequest{
+		Filters: filters,
+		Sorts: []externalEonSdkAPI.SortRestoreJobBy{
+			{Field: externalEonSdkAPI.RESTORE_JOB_SORT_CREATED_TIME, Order: externalEonSdkAPI.DESC},
+		},
+	}
+	jobs := []externalEonSdkAPI.RestoreJob{}
+	pageToken := ""
+
+	for {
+		apiReq := c.client.JobsAPI.ListRestoreJobs(ctx, c.ProjectID).ListRestoreJobsRequest(listReq)
+		if pageToken != "" {
+			apiReq = apiReq.PageToken(pageToken)
+		}
+
+		resp, httpResp, err := apiReq.Execute()
+		if apiErr := c.handleAPIError(err, httpResp, "failed to list restore jobs"); apiErr != nil {
+			return nil, apiErr
+		}
+
+		if httpResp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(httpResp.Body)
+			httpResp.Body.Close()
+			return nil, fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+		}
+		httpResp.Body.Close()
+
+		for _, job := range resp.GetJobs() {
+			if match != nil && !match(&job) {
+				continue
+			}
+			jobs = append(jobs, job)
+			if maxResults > 0 && len(jobs) == maxResults {
+				return jobs, nil
+			}
+		}
+
+		pageToken = resp.GetNextToken()
+		if pageToken == "" {
+			break
+		}
+	}
+
+	return jobs, nil
+}
+
+// ListBackupJobs retrieves the backup jobs matching the given filters, newest first. Jobs for which match
+// returns false are skipped. If maxResults is positive, paging stops once that many jobs have been found.
+func (c *EonClient) ListBackupJobs(ctx context.Context, filters *externalEonSdkAPI.BackupJobsFilterConditions, maxResults int, match func(*externalEonSdkAPI.BackupJob) bool) ([]externalEonSdkAPI.BackupJob, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	listReq := externalEonSdkAPI.ListBackupJobsRequest{
+		Filters: filters,
+		Sorts: []externalEonSdkAPI.SortBackupJobBy{
+			{Field: externalEonSdkAPI.BACKUP_JOB_SORT_CREATED_TIME, Order: externalEonSdkAPI.DESC},
+		},
+	}
+	jobs := []externalEonSdkAPI.BackupJob{}
+	pageToken := ""
+
+	for {
+		apiReq := c.client.JobsAPI.ListBackupJobs(ctx, c.ProjectID).ListBackupJobsRequest(listReq)
+		if pageToken != "" {
+			apiReq = apiReq.PageToken(pageToken)
+		}
+
+		resp, httpResp, err := apiReq.Execute()
+		if apiErr := c.handleAPIError(err, httpResp, "failed to list backup jobs"); apiErr != nil {
+			return nil, apiErr
+		}
+
+		if httpResp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(httpResp.Body)
+			httpResp.Body.Close()
+			return nil, fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+		}
+		httpResp.Body.Close()
+
+		for _, job := range resp.GetJobs() {
+			if match != nil && !match(&job) {
+				continue
+			}
+			jobs = append(jobs, job)
+			if maxResults > 0 && len(jobs) == maxResults {
+				return jobs, nil
+			}
+		}
+
+		pageToken = resp.GetNextToken()
+		if pageToken == "" {
+			break
+		}
+	}
+
+	return jobs, nil
+}
+
 // StartVolumeRestore starts a volume restore job
 func (c *EonClient) StartVolumeRestore(ctx context.Context, resourceId, snapshotId string, req externalEonSdkAPI.RestoreVolumeToEbsRequest) (string, error) {
 	if err := c.ensureValidToken(); err != nil {
@@ -288,6 +608,127 @@ func (c *EonClient) GetResourceById(ctx context.Context, resourceId string) (*ex
 	return &resource, nil
 }
 
+// ListResources retrieves all inventory resources matching the given filters
+func (c *EonClient) ListResources(ctx context.Context, filters *externalEonSdkAPI.InventoryFilterConditions) ([]externalEonSdkAPI.InventoryResource, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	listReq := externalEonSdkAPI.ListInventoryRequest{Filters: filters}
+	resources := []externalEonSdkAPI.InventoryResource{}
+	pageToken := ""
+
+	for {
+		apiReq := c.client.ResourcesAPI.ListResources(ctx, c.ProjectID).ListInventoryRequest(listReq)
+		if pageToken != "" {
+			apiReq = apiReq.PageToken(pageToken)
+		}
+
+		resp, httpResp, err := apiReq.Execute()
+		if apiErr := c.handleAPIError(err, httpResp, "failed to list resources"); apiErr != nil {
+			return nil, apiErr
+		}
+
+		if httpResp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(httpResp.Body)
+			httpResp.Body.Close()
+			return nil, fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+		}
+		httpResp.Body.Close()
+
+		resources = append(resources, resp.GetResources()...)
+
+		pageToken = resp.GetNextToken()
+		if pageToken == "" {
+			break
+		}
+	}
+
+	return resources, nil
+}
+
+// GetLatestSnapshot retrieves the most recent snapshot of a resource, or nil if it has none
+func (c *EonClient) GetLatestSnapshot(ctx context.Context, resourceId string) (*externalEonSdkAPI.Snapshot, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	listReq := externalEonSdkAPI.ListInventorySnapshotsRequest{
+		Sorts: []externalEonSdkAPI.SortSnapshotsBy{
+			{Field: externalEonSdkAPI.SNAPSHOT_SORT_POINT_IN_TIME, Order: externalEonSdkAPI.DESC},
+		},
+	}
+
+	resp, httpResp, err := c.client.SnapshotsAPI.ListResourceSnapshots(ctx, resourceId, c.ProjectID).PageSize(1).ListInventorySnapshotsRequest(listReq).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to list resource snapshots"); apiErr != nil {
+		return nil, apiErr
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(httpResp.Body)
+		return nil, fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	snapshots := resp.GetSnapshots()
+	if len(snapshots) == 0 {
+		return nil, nil
+	}
+
+	return &snapshots[0], nil
+}
+
+// GetSnapshotAtPointInTime retrieves the latest snapshot of a resource taken at or before pointInTime,
+// or nil if there is none
+func (c *EonClient) GetSnapshotAtPointInTime(ctx context.Context, resourceId string, pointInTime time.Time) (*externalEonSdkAPI.Snapshot, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return nil, fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	// The API filters by date only, so snapshots later on the same day are skipped below
+	endDate := pointInTime.UTC().Format("2006-01-02")
+	listReq := externalEonSdkAPI.ListInventorySnapshotsRequest{
+		Filters: &externalEonSdkAPI.SnapshotFilterConditions{
+			PointInTime: &externalEonSdkAPI.SnapshotDateFilters{EndDate: &endDate},
+		},
+		Sorts: []externalEonSdkAPI.SortSnapshotsBy{
+			{Field: externalEonSdkAPI.SNAPSHOT_SORT_POINT_IN_TIME, Order: externalEonSdkAPI.DESC},
+		},
+	}
+	pageToken := ""
+
+	for {
+		apiReq := c.client.SnapshotsAPI.ListResourceSnapshots(ctx, resourceId, c.ProjectID).ListInventorySnapshotsRequest(listReq)
+		if pageToken != "" {
+			apiReq = apiReq.PageToken(pageToken)
+		}
+
+		resp, httpResp, err := apiReq.Execute()
+		if apiErr := c.handleAPIError(err, httpResp, "failed to list resource snapshots"); apiErr != nil {
+			return nil, apiErr
+		}
+
+		if httpResp.StatusCode != http.StatusOK {
+			body, _ := io.ReadAll(httpResp.Body)
+			httpResp.Body.Close()
+			return nil, fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+		}
+		httpResp.Body.Close()
+
+		for _, snapshot := range resp.GetSnapshots() {
+			if snapshot.PointInTime != nil && !snapshot.PointInTime.After(pointInTime) {
+				return &snapshot, nil
+			}
+		}
+
+		pageToken = resp.GetNextToken()
+		if pageToken == "" {
+			return nil, nil
+		}
+	}
+}
+
 // StartRdsRestore starts an RDS restore job
 func (c *EonClient) StartRdsRestore(ctx context.Context, resourceId, snapshotId string, req externalEonSdkAPI.RestoreDbToRdsInstanceRequest) (string, error) {
 	if err := c.ensureValidToken(); err != nil {
@@ -309,6 +750,27 @@ func (c *EonClient) StartRdsRestore(ctx context.Context, resourceId, snapshotId
 	return resp.GetJobId(), nil
 }
 
+// StartDynamoDbRestore starts a DynamoDB table restore job
+func (c *EonClient) StartDynamoDbRestore(ctx context.Context, resourceId, snapshotId string, req externalEonSdkAPI.RestoreDynamoDBTableInput) (string, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return "", fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	resp, httpResp, err := c.client.SnapshotsAPI.RestoreDynamoDBTable(ctx, c.ProjectID, resourceId, snapshotId).RestoreDynamoDBTableInput(req).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to start DynamoDB restore"); apiErr != nil {
+		return "", apiErr
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(httpResp.Body)
+		return "", fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	return resp.GetJobId(), nil
+}
+
 // StartEc2InstanceRestore starts an EC2 instance restore job
 func (c *EonClient) StartEc2InstanceRestore(ctx context.Context, resourceId, snapshotId string, req externalEonSdkAPI.RestoreInstanceInput) (string, error) {
 	if err := c.ensureValidToken(); err != nil {
@@ -372,6 +834,48 @@ func (c *EonClient) StartS3FileRestore(ctx context.Context, resourceId, snapshot
 	return resp.GetJobId(), nil
 }
 
+// StartAzureBlobRestore starts an Azure Blob Storage restore job
+func (c *EonClient) StartAzureBlobRestore(ctx context.Context, resourceId, snapshotId string, req externalEonSdkAPI.RestoreBucketRequest) (string, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return "", fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	resp, httpResp, err := c.client.SnapshotsAPI.RestoreBucket(ctx, c.ProjectID, resourceId, snapshotId).RestoreBucketRequest(req).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to start Azure Blob restore"); apiErr != nil {
+		return "", apiErr
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(httpResp.Body)
+		return "", fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	return resp.GetJobId(), nil
+}
+
+// StartAzureBlobFileRestore starts an Azure Blob Storage file restore job
+func (c *EonClient) StartAzureBlobFileRestore(ctx context.Context, resourceId, snapshotId string, req externalEonSdkAPI.RestoreFilesRequest) (string, error) {
+	if err := c.ensureValidToken(); err != nil {
+		return "", fmt.Errorf("failed to ensure valid token: %w", err)
+	}
+
+	resp, httpResp, err := c.client.SnapshotsAPI.RestoreFiles(ctx, c.ProjectID, resourceId, snapshotId).RestoreFilesRequest(req).Execute()
+	if apiErr := c.handleAPIError(err, httpResp, "failed to start Azure Blob file restore"); apiErr != nil {
+		return "", apiErr
+	}
+
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusAccepted {
+		body, _ := io.ReadAll(httpResp.Body)
+		return "", fmt.Errorf("API error %d: %s", httpResp.StatusCode, string(body))
+	}
+
+	return resp.GetJobId(), nil
+}
+
 // GetSnapshot retrieves a snapshot by ID
 func (c *EonClient) GetSnapshot(ctx context.Context, snapshotId string) (*externalEonSdkAPI.Snapshot, error) {
 	if err := c.ensureValidToken(); err != nil {
@@ -394,40 +898,62 @@ func (c *EonClient) GetSnapshot(ctx context.Context, snapshotId string) (*extern
 	return &snapshot, nil
 }
 
-// WaitForRestoreJobCompletion waits for a restore job to complete
+// WaitForRestoreJobCompletion waits for a restore job to complete, logging its progress every time it's polled.
+// If the timeout or ctx's deadline passes first, it returns ErrRestoreJobWaitTimeout. Cancelling ctx stops waiting
+// immediately and returns the context's error.
 func (c *EonClient) WaitForRestoreJobCompletion(ctx context.Context, jobId string, timeout time.Duration) (*externalEonSdkAPI.RestoreJob, error) {
-	ctx, cancel := context.WithTimeout(ctx, timeout)
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
-	ticker := time.NewTicker(10 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ctx.Done():
-			return nil, fmt.Errorf("timeout waiting for restore job %s to complete", jobId)
-		case <-ticker.C:
-			job, err := c.GetRestoreJob(ctx, jobId)
-			if err != nil {
-				return nil, fmt.Errorf("failed to get restore job status: %w", err)
-			}
+	started := time.Now()
+	job, err := Poll(waitCtx, c.PollConfig, func(ctx context.Context) (*externalEonSdkAPI.RestoreJob, bool, error) {
+		job, err := c.GetRestoreJob(ctx, jobId)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to get restore job status: %w", err)
+		}
 
-			if job.GetJobExecutionDetails().Status.Ptr() == nil {
-				continue
-			}
+		logRestoreJobProgress(ctx, job, time.Since(started))
 
-			switch job.GetJobExecutionDetails().Status {
-			case externalEonSdkAPI.JOB_COMPLETED, externalEonSdkAPI.JOB_PARTIAL:
-				return job, nil
-			case externalEonSdkAPI.JOB_FAILED, externalEonSdkAPI.JOB_CANCELLED:
-				errorMsg := "unknown error"
-				if job.GetJobExecutionDetails().StatusMessage != nil {
-					errorMsg = *job.GetJobExecutionDetails().StatusMessage
-				}
-				return job, fmt.Errorf("restore job failed with status: %s, error: %s", job.GetJobExecutionDetails().Status, errorMsg)
+		switch job.GetJobExecutionDetails().Status {
+		case externalEonSdkAPI.JOB_COMPLETED, externalEonSdkAPI.JOB_PARTIAL:
+			return job, true, nil
+		case externalEonSdkAPI.JOB_FAILED, externalEonSdkAPI.JOB_CANCELLED:
+			errorMsg := "unknown error"
+			if job.GetJobExecutionDetails().StatusMessage != nil {
+				errorMsg = *job.GetJobExecutionDetails().StatusMessage
 			}
+			return job, true, fmt.Errorf("restore job failed with status: %s, error: %s", job.GetJobExecutionDetails().Status, errorMsg)
 		}
+		return nil, false, nil
+	})
+
+	// Callers usually bound ctx by the same operation timeout, so its deadline is a wait timeout too
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, fmt.Errorf("%w: %s", ErrRestoreJobWaitTimeout, jobId)
+	}
+	return job, err
+}
+
+// logRestoreJobProgress logs the status of a restore job that's being waited on
+func logRestoreJobProgress(ctx context.Context, job *externalEonSdkAPI.RestoreJob, elapsed time.Duration) {
+	execution := job.GetJobExecutionDetails()
+	fields := map[string]interface{}{
+		"job_id":       execution.JobId,
+		"status":       string(execution.Status),
+		"restore_type": string(job.RestoreType),
+		"elapsed":      elapsed.Round(time.Second).String(),
+	}
+	if resource := job.ResourceDetails.Get(); resource != nil {
+		fields["resource_name"] = resource.ResourceName
+		fields["source_size_bytes"] = resource.SourceStorageSizeBytes
 	}
+	if startTime := execution.StartTime.Get(); startTime != nil {
+		fields["running_for"] = time.Since(*startTime).Round(time.Second).String()
+	} else if expectedStartTime := execution.ExpectedStartTime.Get(); expectedStartTime != nil {
+		fields["expected_start_time"] = expectedStartTime.Format(time.RFC3339)
+	}
+
+	tflog.Info(ctx, "Waiting for restore job", fields)
 }
 
 // ListBackupPolicies retrieves all backup policies for the project
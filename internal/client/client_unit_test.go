@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient returns a client for a fake Eon API that knows a single source and restore account, "account-1"
+func newTestClient(t *testing.T) *EonClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/token") {
+			_, _ = w.Write([]byte(`{"accessToken": "token", "expirationSeconds": 3600}`))
+			return
+		}
+
+		var listReq externalEonSdkAPI.ListSourceAccountsRequest
+		if err := json.NewDecoder(r.Body).Decode(&listReq); err != nil || listReq.Filters == nil || listReq.Filters.Id == nil {
+			http.Error(w, `{"error": "expected an id filter"}`, http.StatusBadRequest)
+			return
+		}
+
+		accounts := []map[string]interface{}{}
+		if listReq.Filters.Id.In[0] == "account-1" {
+			accounts = append(accounts, map[string]interface{}{
+				"id":                "account-1",
+				"name":              "production",
+				"providerAccountId": "123456789012",
+				"status":            "CONNECTED",
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"accounts": accounts, "totalCount": len(accounts)})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewEonClient(server.URL, "client-id", "client-secret", "project-id")
+	require.NoError(t, err)
+	return c
+}
+
+// TestGetAccounts tests looking up accounts by ID
+func TestGetAccounts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := newTestClient(t)
+
+	sourceAccount, err := c.GetSourceAccount(ctx, "account-1")
+	require.NoError(t, err)
+	assert.Equal(t, "production", sourceAccount.Name)
+
+	restoreAccount, err := c.GetRestoreAccount(ctx, "account-1")
+	require.NoError(t, err)
+	assert.Equal(t, "123456789012", restoreAccount.ProviderAccountId)
+
+	_, err = c.GetSourceAccount(ctx, "account-2")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	_, err = c.GetRestoreAccount(ctx, "account-2")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+// TestListRestoreJobsMaxResults tests that paging through restore jobs stops once enough matching jobs are found
+func TestListRestoreJobsMaxResults(t *testing.T) {
+	t.Parallel()
+
+	var pages atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/token") {
+			_, _ = w.Write([]byte(`{"accessToken": "token", "expirationSeconds": 3600}`))
+			return
+		}
+
+		pages.Add(1)
+		page := r.URL.Query().Get("pageToken")
+		if page == "" {
+			page = "1"
+		}
+		jobs := []map[string]interface{}{}
+		for _, suffix := range []string{"a", "b"} {
+			jobs = append(jobs, map[string]interface{}{
+				"jobExecutionDetails": map[string]interface{}{"jobId": "job-" + page + suffix, "status": "JOB_COMPLETED", "createdTime": "2024-06-01T12:00:00Z"},
+				"destinationDetails":  map[string]interface{}{"restoreAccountId": "account-1", "providerAccountId": "123456789012", "cloudProvider": "AWS", "region": "us-east-1"},
+				"restoreType":         "AWS_EC2_EBS_VOLUME_RESTORE",
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"jobs": jobs, "totalCount": 100, "nextToken": page + "0"})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewEonClient(server.URL, "client-id", "client-secret", "project-id")
+	require.NoError(t, err)
+
+	jobs, err := c.ListRestoreJobs(context.Background(), nil, 2, func(job *externalEonSdkAPI.RestoreJob) bool {
+		return strings.HasSuffix(job.JobExecutionDetails.JobId, "a")
+	})
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "job-1a", jobs[0].JobExecutionDetails.JobId)
+	assert.Equal(t, "job-10a", jobs[1].JobExecutionDetails.JobId)
+	assert.Equal(t, int32(2), pages.Load())
+}
+
+// TestWaitForRestoreJobCompletionTimeout tests that running out of time while a restore job runs is a wait timeout,
+// whether the wait timeout or the caller's deadline passes first
+func TestWaitForRestoreJobCompletionTimeout(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/token") {
+			_, _ = w.Write([]byte(`{"accessToken": "token", "expirationSeconds": 3600}`))
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"job": map[string]interface{}{
+			"jobExecutionDetails": map[string]interface{}{"jobId": "job-1", "status": "JOB_RUNNING", "createdTime": "2024-06-01T12:00:00Z"},
+			"destinationDetails":  map[string]interface{}{"restoreAccountId": "account-1", "providerAccountId": "123456789012", "cloudProvider": "AWS", "region": "us-east-1"},
+			"restoreType":         "AWS_EC2_EBS_VOLUME_RESTORE",
+		}})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := NewEonClient(server.URL, "client-id", "client-secret", "project-id")
+	require.NoError(t, err)
+	c.PollConfig = PollConfig{Interval: 10 * time.Millisecond}
+
+	_, err = c.WaitForRestoreJobCompletion(context.Background(), "job-1", 50*time.Millisecond)
+	assert.ErrorIs(t, err, ErrRestoreJobWaitTimeout)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = c.WaitForRestoreJobCompletion(ctx, "job-1", 50*time.Millisecond)
+	assert.ErrorIs(t, err, ErrRestoreJobWaitTimeout)
+
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	_, err = c.WaitForRestoreJobCompletion(ctx, "job-1", time.Minute)
+	assert.ErrorIs(t, err, context.Canceled)
+}
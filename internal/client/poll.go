@@ -0,0 +1,61 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// PollConfig controls how often long-running operations are polled. The interval starts at Interval and
+// grows by Multiplier after every poll, up to MaxInterval.
+type PollConfig struct {
+	Interval    time.Duration
+	MaxInterval time.Duration
+	Multiplier  float64
+}
+
+// DefaultPollConfig polls every 10 seconds at first, backing off to every 2 minutes for long-running jobs
+var DefaultPollConfig = PollConfig{
+	Interval:    10 * time.Second,
+	MaxInterval: 2 * time.Minute,
+	Multiplier:  1.5,
+}
+
+// NextInterval returns the interval to wait after polling at the given interval
+func (p PollConfig) NextInterval(interval time.Duration) time.Duration {
+	if p.Multiplier > 1 {
+		interval = time.Duration(float64(interval) * p.Multiplier)
+	}
+	if p.MaxInterval > 0 && interval > p.MaxInterval {
+		interval = p.MaxInterval
+	}
+	return interval
+}
+
+// Poll calls check after each interval until it reports done or returns an error, and returns its result.
+// If ctx is done first, or check fails because ctx is done, Poll returns immediately with the context's error.
+func Poll[T any](ctx context.Context, config PollConfig, check func(ctx context.Context) (T, bool, error)) (T, error) {
+	var zero T
+	interval := config.Interval
+
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return zero, ctx.Err()
+		case <-timer.C:
+		}
+
+		result, done, err := check(ctx)
+		if err != nil && ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		if err != nil || done {
+			return result, err
+		}
+
+		interval = config.NextInterval(interval)
+		timer.Reset(interval)
+	}
+}
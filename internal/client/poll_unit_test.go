@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestPollConfigNextInterval tests that poll intervals grow exponentially up to the cap
+func TestPollConfigNextInterval(t *testing.T) {
+	t.Parallel()
+
+	config := PollConfig{Interval: 10 * time.Second, MaxInterval: time.Minute, Multiplier: 2}
+	assert.Equal(t, 20*time.Second, config.NextInterval(10*time.Second))
+	assert.Equal(t, 40*time.Second, config.NextInterval(20*time.Second))
+	assert.Equal(t, time.Minute, config.NextInterval(40*time.Second))
+	assert.Equal(t, time.Minute, config.NextInterval(time.Minute))
+
+	fixed := PollConfig{Interval: 10 * time.Second}
+	assert.Equal(t, 10*time.Second, fixed.NextInterval(10*time.Second))
+}
+
+// TestPoll tests polling until a check finishes, fails or its context is done
+func TestPoll(t *testing.T) {
+	t.Parallel()
+
+	config := PollConfig{Interval: time.Millisecond, MaxInterval: 4 * time.Millisecond, Multiplier: 2}
+
+	t.Run("done", func(t *testing.T) {
+		t.Parallel()
+
+		calls := 0
+		result, err := Poll(context.Background(), config, func(ctx context.Context) (int, bool, error) {
+			calls++
+			return calls, calls == 3, nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 3, result)
+	})
+
+	t.Run("check error", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := Poll(context.Background(), config, func(ctx context.Context) (int, bool, error) {
+			return 0, false, errors.New("boom")
+		})
+		assert.EqualError(t, err, "boom")
+	})
+
+	t.Run("cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		slow := PollConfig{Interval: time.Hour}
+
+		go cancel()
+		started := time.Now()
+		_, err := Poll(ctx, slow, func(ctx context.Context) (int, bool, error) {
+			return 0, false, nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, time.Since(started), time.Minute)
+	})
+
+	t.Run("check fails because context is done", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		_, err := Poll(ctx, config, func(ctx context.Context) (int, bool, error) {
+			<-ctx.Done()
+			return 0, false, errors.New("request aborted")
+		})
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -210,11 +210,11 @@ func TestEonProvider_ProviderSchema(t *testing.T) {
 	require.NotNil(t, resp.Schema)
 	assert.False(t, resp.Diagnostics.HasError())
 
-	// Test that we have exactly 4 attributes
-	assert.Equal(t, 4, len(resp.Schema.Attributes))
+	// Test that we have exactly 6 attributes
+	assert.Equal(t, 6, len(resp.Schema.Attributes))
 
 	// Test attribute names
-	expectedAttributes := []string{"endpoint", "client_id", "client_secret", "project_id"}
+	expectedAttributes := []string{"endpoint", "client_id", "client_secret", "project_id", "poll_interval", "max_poll_interval"}
 	for _, attr := range expectedAttributes {
 		assert.Contains(t, resp.Schema.Attributes, attr)
 	}
@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &BackupJobsDataSource{}
+
+func NewBackupJobsDataSource() datasource.DataSource {
+	return &BackupJobsDataSource{}
+}
+
+type BackupJobsDataSource struct {
+	client *client.EonClient
+}
+
+type BackupJobsDataSourceModel struct {
+	JobFiltersModel
+	BackupTypes types.List         `tfsdk:"backup_types"`
+	Jobs        []BackupJobSummary `tfsdk:"jobs"`
+}
+
+type BackupJobSummary struct {
+	JobExecutionModel
+	BackupType types.String `tfsdk:"backup_type"`
+}
+
+func (d *BackupJobsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_jobs"
+}
+
+func (d *BackupJobsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := jobFilterAttributes()
+	attributes["backup_types"] = schema.ListAttribute{
+		MarkdownDescription: "Only return jobs of these backup types, such as `AWS_EC2_BACKUP` or `AWS_RDS_BACKUP`.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+
+	jobAttributes := jobExecutionAttributes()
+	jobAttributes["backup_type"] = schema.StringAttribute{
+		MarkdownDescription: "Backup type, such as `AWS_EC2_BACKUP`.",
+		Computed:            true,
+	}
+	attributes["jobs"] = schema.ListNestedAttribute{
+		MarkdownDescription: "List of matching backup jobs, newest first.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: jobAttributes,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the history of backup jobs in the Eon project, optionally filtered by status, resource, backup policy, backup type and start time. All filters that are set must match.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *BackupJobsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.EonClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.EonClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupJobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackupJobsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, err := buildJobFilters(ctx, &data.JobFiltersModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Invalid backup job filter: %s", err))
+		return
+	}
+
+	if err := restrictJobFiltersToResources(ctx, d.client, filters); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource_ids: %s", err))
+		return
+	}
+
+	if err := restrictJobFiltersToBackupPolicy(ctx, d.client, &data.JobFiltersModel, filters); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resources of backup policy %s: %s", data.BackupPolicyId.ValueString(), err))
+		return
+	}
+
+	apiFilters, err := buildBackupJobsFilterConditions(ctx, &data, filters)
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Invalid backup job filter: %s", err))
+		return
+	}
+
+	data.Jobs = []BackupJobSummary{}
+	if !filters.matchesNothing {
+		jobs, err := d.client.ListBackupJobs(ctx, apiFilters, int(data.MaxResults.ValueInt64()), func(job *externalEonSdkAPI.BackupJob) bool {
+			return filters.matches(job.GetResourceDetails(), job.JobExecutionDetails)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup jobs: %s", err))
+			return
+		}
+
+		for _, job := range jobs {
+			data.Jobs = append(data.Jobs, newBackupJobSummary(&job))
+		}
+	}
+
+	tflog.Debug(ctx, "Read backup jobs", map[string]interface{}{
+		"count": len(data.Jobs),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildBackupJobsFilterConditions converts the parsed filters and backup types into backup job API filters
+func buildBackupJobsFilterConditions(ctx context.Context, data *BackupJobsDataSourceModel, filters *jobFilters) (*externalEonSdkAPI.BackupJobsFilterConditions, error) {
+	conditions := &externalEonSdkAPI.BackupJobsFilterConditions{
+		Status:             filters.status,
+		ProviderResourceId: filters.providerResourceId,
+		SnapshotId:         filters.snapshotId,
+		StartTime:          filters.startTime,
+	}
+
+	if !data.BackupTypes.IsNull() {
+		var backupTypeValues []string
+		if diags := data.BackupTypes.ElementsAs(ctx, &backupTypeValues, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse backup_types list")
+		}
+
+		var backupTypes []externalEonSdkAPI.BackupJobType
+		for _, value := range backupTypeValues {
+			backupType, err := externalEonSdkAPI.NewBackupJobTypeFromValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("unsupported backup type %q", value)
+			}
+			backupTypes = append(backupTypes, *backupType)
+		}
+		conditions.BackupType = &externalEonSdkAPI.BackupJobTypeFilters{In: backupTypes}
+	}
+
+	return conditions, nil
+}
+
+// newBackupJobSummary converts a backup job returned by the API into its Terraform model
+func newBackupJobSummary(job *externalEonSdkAPI.BackupJob) BackupJobSummary {
+	return BackupJobSummary{
+		JobExecutionModel: newJobExecutionModel(job.JobExecutionDetails, job.ResourceDetails.Get(), job.SnapshotDetails.Get(), job.Vault.Get()),
+		BackupType:        types.StringValue(string(job.BackupType)),
+	}
+}
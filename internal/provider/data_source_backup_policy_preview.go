@@ -0,0 +1,518 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &BackupPolicyPreviewDataSource{}
+
+func NewBackupPolicyPreviewDataSource() datasource.DataSource {
+	return &BackupPolicyPreviewDataSource{}
+}
+
+type BackupPolicyPreviewDataSource struct {
+	client *client.EonClient
+}
+
+type BackupPolicyPreviewDataSourceModel struct {
+	ResourceSelector        types.Object             `tfsdk:"resource_selector"`
+	ComparePolicyId         types.String             `tfsdk:"compare_policy_id"`
+	MatchedCount            types.Int64              `tfsdk:"matched_count"`
+	CountByResourceType     map[string]int64         `tfsdk:"count_by_resource_type"`
+	CountByAccount          map[string]int64         `tfsdk:"count_by_account"`
+	CountByRegion           map[string]int64         `tfsdk:"count_by_region"`
+	Resources               []InventoryResourceModel `tfsdk:"resources"`
+	ResourcesLosingCoverage []InventoryResourceModel `tfsdk:"resources_losing_coverage"`
+}
+
+func (d *BackupPolicyPreviewDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_policy_preview"
+}
+
+func (d *BackupPolicyPreviewDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	// Reuse the backup policy resource's selector schema so previews always accept the same configuration.
+	var policySchema resource.SchemaResponse
+	(&BackupPolicyResource{}).Schema(ctx, resource.SchemaRequest{}, &policySchema)
+	resourceSelector, err := dataSourceAttributeFromResourceAttribute(policySchema.Schema.Attributes["resource_selector"])
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid Provider Schema",
+			fmt.Sprintf("Unable to reuse the backup policy resource_selector schema: %s. Please report this issue to the provider developers.", err),
+		)
+		return
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Previews which inventory resources a backup policy `resource_selector` matches, without creating or changing a policy. The selector is evaluated against the current inventory, so results can differ from Eon's own evaluation for resources that changed since the inventory was last refreshed.",
+		Attributes: map[string]schema.Attribute{
+			"resource_selector": resourceSelector,
+			"compare_policy_id": schema.StringAttribute{
+				MarkdownDescription: "ID of an existing backup policy to compare against. When set, `resources_losing_coverage` lists the resources that the existing policy matches but `resource_selector` doesn't.",
+				Optional:            true,
+			},
+			"matched_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of inventory resources matched by `resource_selector`.",
+				Computed:            true,
+			},
+			"count_by_resource_type": schema.MapAttribute{
+				MarkdownDescription: "Number of matched resources per resource type.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"count_by_account": schema.MapAttribute{
+				MarkdownDescription: "Number of matched resources per cloud-provider-assigned account ID.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"count_by_region": schema.MapAttribute{
+				MarkdownDescription: "Number of matched resources per region.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "Inventory resources matched by `resource_selector`.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: inventoryResourceAttributes(schema.StringAttribute{
+						MarkdownDescription: "Eon-assigned resource ID.",
+						Computed:            true,
+					}),
+				},
+			},
+			"resources_losing_coverage": schema.ListNestedAttribute{
+				MarkdownDescription: "Inventory resources matched by the policy in `compare_policy_id` but not by `resource_selector`. Empty when `compare_policy_id` isn't set.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: inventoryResourceAttributes(schema.StringAttribute{
+						MarkdownDescription: "Eon-assigned resource ID.",
+						Computed:            true,
+					}),
+				},
+			},
+		},
+	}
+}
+
+func (d *BackupPolicyPreviewDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.EonClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.EonClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *BackupPolicyPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data BackupPolicyPreviewDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	selector, diags := createBackupPolicyResourceSelector(ctx, data.ResourceSelector)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var comparedSelector *externalEonSdkAPI.BackupPolicyResourceSelector
+	if !data.ComparePolicyId.IsNull() && data.ComparePolicyId.ValueString() != "" {
+		policy, err := d.client.GetBackupPolicy(ctx, data.ComparePolicyId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup policy %s: %s", data.ComparePolicyId.ValueString(), err))
+			return
+		}
+		comparedSelector = &policy.ResourceSelector
+	}
+
+	resources, err := d.client.ListResources(ctx, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inventory resources: %s", err))
+		return
+	}
+
+	data.CountByResourceType = map[string]int64{}
+	data.CountByAccount = map[string]int64{}
+	data.CountByRegion = map[string]int64{}
+	data.Resources = []InventoryResourceModel{}
+	data.ResourcesLosingCoverage = []InventoryResourceModel{}
+
+	for _, resource := range resources {
+		matches, err := backupPolicySelectorMatches(selector, &resource)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Conditional Expression", fmt.Sprintf("Unable to evaluate resource_selector: %s", err))
+			return
+		}
+
+		losesCoverage := false
+		if comparedSelector != nil && !matches {
+			losesCoverage, err = backupPolicySelectorMatches(comparedSelector, &resource)
+			if err != nil {
+				resp.Diagnostics.AddError("Invalid Conditional Expression", fmt.Sprintf("Unable to evaluate the resource selector of backup policy %s: %s", data.ComparePolicyId.ValueString(), err))
+				return
+			}
+		}
+
+		if !matches && !losesCoverage {
+			continue
+		}
+
+		resourceModel, diags := newInventoryResourceModel(ctx, &resource, "")
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if losesCoverage {
+			data.ResourcesLosingCoverage = append(data.ResourcesLosingCoverage, resourceModel)
+			continue
+		}
+
+		data.Resources = append(data.Resources, resourceModel)
+		data.CountByResourceType[string(resource.ResourceType)]++
+		data.CountByAccount[resource.ProviderAccountId]++
+		data.CountByRegion[resource.Region]++
+	}
+
+	data.MatchedCount = types.Int64Value(int64(len(data.Resources)))
+
+	tflog.Debug(ctx, "Previewed backup policy resource selector", map[string]interface{}{
+		"matched_count":         len(data.Resources),
+		"losing_coverage_count": len(data.ResourcesLosingCoverage),
+		"inventory_size":        len(resources),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// dataSourceAttributeFromResourceAttribute converts a resource schema attribute into the equivalent
+// data source attribute. Only the attribute kinds used by the backup policy resource selector are supported.
+func dataSourceAttributeFromResourceAttribute(attribute resourceschema.Attribute) (schema.Attribute, error) {
+	switch a := attribute.(type) {
+	case resourceschema.StringAttribute:
+		return schema.StringAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+		}, nil
+	case resourceschema.BoolAttribute:
+		return schema.BoolAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+		}, nil
+	case resourceschema.Int64Attribute:
+		return schema.Int64Attribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+		}, nil
+	case resourceschema.ListAttribute:
+		return schema.ListAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			ElementType:         a.ElementType,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+		}, nil
+	case resourceschema.SingleNestedAttribute:
+		attributes, err := dataSourceAttributesFromResourceAttributes(a.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return schema.SingleNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Attributes:          attributes,
+			Required:            a.Required,
+			Optional:            a.Optional,
+			Computed:            a.Computed,
+		}, nil
+	case resourceschema.ListNestedAttribute:
+		attributes, err := dataSourceAttributesFromResourceAttributes(a.NestedObject.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return schema.ListNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: attributes,
+			},
+			Required: a.Required,
+			Optional: a.Optional,
+			Computed: a.Computed,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported resource schema attribute type %T", attribute)
+	}
+}
+
+func dataSourceAttributesFromResourceAttributes(attributes map[string]resourceschema.Attribute) (map[string]schema.Attribute, error) {
+	converted := make(map[string]schema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		convertedAttribute, err := dataSourceAttributeFromResourceAttribute(attribute)
+		if err != nil {
+			return nil, fmt.Errorf("attribute %s: %w", name, err)
+		}
+		converted[name] = convertedAttribute
+	}
+	return converted, nil
+}
+
+// backupPolicySelectorMatches reports whether a backup policy resource selector matches an inventory resource.
+// Inclusion and exclusion overrides hold cloud-provider-assigned resource IDs, and exclusions take precedence.
+func backupPolicySelectorMatches(selector *externalEonSdkAPI.BackupPolicyResourceSelector, resource *externalEonSdkAPI.InventoryResource) (bool, error) {
+	if slices.Contains(selector.ResourceExclusionOverride, resource.ProviderResourceId) {
+		return false, nil
+	}
+
+	if slices.Contains(selector.ResourceInclusionOverride, resource.ProviderResourceId) {
+		return true, nil
+	}
+
+	switch selector.ResourceSelectionMode {
+	case externalEonSdkAPI.RESOURCE_SELECTOR_MODE_ALL:
+		return true, nil
+	case externalEonSdkAPI.RESOURCE_SELECTOR_MODE_NONE:
+		return false, nil
+	case externalEonSdkAPI.RESOURCE_SELECTOR_MODE_CONDITIONAL:
+		expression := selector.Expression.Get()
+		if expression == nil {
+			return false, fmt.Errorf("expression is required for CONDITIONAL resource selection mode")
+		}
+		return backupPolicyExpressionMatches(expression, resource)
+	default:
+		return false, fmt.Errorf("unsupported resource selection mode %q", selector.ResourceSelectionMode)
+	}
+}
+
+// backupPolicyExpressionMatches evaluates a backup policy expression against an inventory resource.
+// Every condition set on the expression must match.
+func backupPolicyExpressionMatches(expression *externalEonSdkAPI.BackupPolicyExpression, resource *externalEonSdkAPI.InventoryResource) (bool, error) {
+	if group := expression.Group.Get(); group != nil {
+		matches, err := backupPolicyGroupMatches(group, resource)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.ResourceType.Get(); condition != nil {
+		var values []string
+		for _, v := range condition.ResourceTypes {
+			values = append(values, string(v))
+		}
+		matches, err := scalarConditionMatches("resource_type", string(condition.Operator), values, string(resource.ResourceType))
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.Environment.Get(); condition != nil {
+		var values []string
+		for _, v := range condition.Environments {
+			values = append(values, string(v))
+		}
+		environment := ""
+		if resource.Classifications != nil && resource.Classifications.EnvironmentDetails != nil && resource.Classifications.EnvironmentDetails.Environment != nil {
+			environment = string(*resource.Classifications.EnvironmentDetails.Environment)
+		}
+		matches, err := scalarConditionMatches("environment", string(condition.Operator), values, environment)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.CloudProvider.Get(); condition != nil {
+		var values []string
+		for _, v := range condition.CloudProviders {
+			values = append(values, string(v))
+		}
+		matches, err := scalarConditionMatches("cloud_provider", string(condition.Operator), values, string(resource.CloudProvider))
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.AccountId.Get(); condition != nil {
+		matches, err := scalarConditionMatches("account_id", string(condition.Operator), condition.AccountIds, resource.ProviderAccountId)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.SourceRegion.Get(); condition != nil {
+		matches, err := scalarConditionMatches("source_region", string(condition.Operator), condition.Regions, resource.Region)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.Vpc.Get(); condition != nil {
+		vpc := ""
+		if resource.Vpc != nil {
+			vpc = *resource.Vpc
+		}
+		matches, err := scalarConditionMatches("vpc", string(condition.Operator), condition.Vpcs, vpc)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.ResourceName.Get(); condition != nil {
+		matches, err := scalarConditionMatches("resource_name", string(condition.Operator), condition.ResourceNames, resource.ResourceName)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.ResourceId.Get(); condition != nil {
+		matches, err := scalarConditionMatches("resource_id", string(condition.Operator), condition.ResourceIds, resource.ProviderResourceId)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if expression.ResourceGroupName.Get() != nil {
+		return false, fmt.Errorf("resource_group_name conditions can't be previewed because inventory resources don't report their resource group")
+	}
+
+	if condition := expression.Subnets.Get(); condition != nil {
+		matches, err := listConditionMatches("subnets", string(condition.Operator), condition.Subnets, resource.Subnets)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.TagKeys.Get(); condition != nil {
+		var tagKeys []string
+		for key := range resource.Tags {
+			tagKeys = append(tagKeys, key)
+		}
+		matches, err := listConditionMatches("tag_keys", string(condition.Operator), condition.TagKeys, tagKeys)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.TagKeyValues.Get(); condition != nil {
+		var values, tagPairs []string
+		for _, kv := range condition.TagKeyValues {
+			values = append(values, kv.Key+"="+kv.GetValue())
+		}
+		for key, value := range resource.Tags {
+			tagPairs = append(tagPairs, key+"="+value)
+		}
+		matches, err := listConditionMatches("tag_key_values", string(condition.Operator), values, tagPairs)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.DataClasses.Get(); condition != nil {
+		var values, dataClasses []string
+		for _, v := range condition.DataClasses {
+			values = append(values, string(v))
+		}
+		if resource.Classifications != nil && resource.Classifications.DataClassesDetails != nil {
+			for _, v := range resource.Classifications.DataClassesDetails.DataClasses {
+				dataClasses = append(dataClasses, string(v))
+			}
+		}
+		matches, err := listConditionMatches("data_classes", string(condition.Operator), values, dataClasses)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if condition := expression.Apps.Get(); condition != nil {
+		var apps []string
+		if resource.Classifications != nil && resource.Classifications.AppsDetails != nil {
+			for _, app := range resource.Classifications.AppsDetails.Apps {
+				apps = append(apps, app.Name)
+			}
+		}
+		matches, err := listConditionMatches("apps", string(condition.Operator), condition.Apps, apps)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func backupPolicyGroupMatches(group *externalEonSdkAPI.BackupPolicyGroupCondition, resource *externalEonSdkAPI.InventoryResource) (bool, error) {
+	switch group.Operator {
+	case externalEonSdkAPI.AND_OPERATOR:
+		for _, operand := range group.Operands {
+			matches, err := backupPolicyExpressionMatches(&operand, resource)
+			if err != nil || !matches {
+				return false, err
+			}
+		}
+		return true, nil
+	case externalEonSdkAPI.OR_OPERATOR:
+		for _, operand := range group.Operands {
+			matches, err := backupPolicyExpressionMatches(&operand, resource)
+			if err != nil {
+				return false, err
+			}
+			if matches {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("unsupported group operator %q, must be AND or OR", group.Operator)
+	}
+}
+
+// listConditionMatches evaluates a CONTAINS_ANY_OF, CONTAINS_NONE_OF or CONTAINS_ALL_OF condition against a list of values
+func listConditionMatches(name, operator string, values []string, resourceValues []string) (bool, error) {
+	switch externalEonSdkAPI.ListOperators(operator) {
+	case externalEonSdkAPI.CONTAINS_ANY_OF_OPERATOR:
+		for _, v := range values {
+			if slices.Contains(resourceValues, v) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case externalEonSdkAPI.CONTAINS_NONE_OF_OPERATOR:
+		for _, v := range values {
+			if slices.Contains(resourceValues, v) {
+				return false, nil
+			}
+		}
+		return true, nil
+	case externalEonSdkAPI.CONTAINS_ALL_OF_OPERATOR:
+		for _, v := range values {
+			if !slices.Contains(resourceValues, v) {
+				return false, nil
+			}
+		}
+		return true, nil
+	default:
+		return false, fmt.Errorf("unsupported %s operator %q, must be CONTAINS_ANY_OF, CONTAINS_NONE_OF or CONTAINS_ALL_OF", name, operator)
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func previewTestResource() *externalEonSdkAPI.InventoryResource {
+	prod := externalEonSdkAPI.PROD
+	return &externalEonSdkAPI.InventoryResource{
+		Id:                 "res-1",
+		ProviderResourceId: "i-0123456789abcdef0",
+		ResourceName:       "web-1",
+		ResourceType:       externalEonSdkAPI.ResourceType("AWS_EC2"),
+		CloudProvider:      externalEonSdkAPI.AWS,
+		ProviderAccountId:  "123456789012",
+		Region:             "us-east-1",
+		Tags:               map[string]string{"team": "payments", "backup": "daily"},
+		Classifications: &externalEonSdkAPI.Classifications{
+			EnvironmentDetails: &externalEonSdkAPI.EnvironmentDetails{Environment: &prod},
+		},
+	}
+}
+
+// TestBackupPolicyPreviewDataSource_Schema tests that the preview schema reuses the backup policy resource selector
+func TestBackupPolicyPreviewDataSource_Schema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resp := &datasource.SchemaResponse{}
+	NewBackupPolicyPreviewDataSource().Schema(ctx, datasource.SchemaRequest{}, resp)
+
+	require.False(t, resp.Diagnostics.HasError())
+	require.False(t, resp.Schema.ValidateImplementation(ctx).HasError())
+
+	selector, ok := resp.Schema.Attributes["resource_selector"].(schema.SingleNestedAttribute)
+	require.True(t, ok, "resource_selector should be a single nested attribute")
+	assert.True(t, selector.Required)
+	assert.Contains(t, selector.Attributes, "expression")
+	assert.Contains(t, selector.Attributes, "resource_inclusion_override")
+}
+
+// TestDataSourceAttributeFromResourceAttribute tests that unsupported attribute kinds are reported instead of converted
+func TestDataSourceAttributeFromResourceAttribute(t *testing.T) {
+	t.Parallel()
+
+	_, err := dataSourceAttributeFromResourceAttribute(resourceschema.SingleNestedAttribute{
+		Attributes: map[string]resourceschema.Attribute{
+			"weight": resourceschema.Float64Attribute{Optional: true},
+		},
+	})
+	assert.ErrorContains(t, err, "attribute weight: unsupported resource schema attribute type schema.Float64Attribute")
+}
+
+// TestBackupPolicySelectorMatches tests local evaluation of backup policy resource selectors
+func TestBackupPolicySelectorMatches(t *testing.T) {
+	t.Parallel()
+
+	resource := previewTestResource()
+	prodOnly := externalEonSdkAPI.NewBackupPolicyExpression()
+	prodOnly.SetEnvironment(*externalEonSdkAPI.NewEnvironmentCondition(externalEonSdkAPI.IN_OPERATOR, []externalEonSdkAPI.Environment{externalEonSdkAPI.STAGE}))
+
+	tagOrRegion := externalEonSdkAPI.NewBackupPolicyExpression()
+	tagOperand := externalEonSdkAPI.NewBackupPolicyExpression()
+	tagOperand.SetTagKeys(*externalEonSdkAPI.NewTagKeysCondition(externalEonSdkAPI.CONTAINS_ANY_OF_OPERATOR, []string{"owner"}))
+	regionOperand := externalEonSdkAPI.NewBackupPolicyExpression()
+	regionOperand.SetSourceRegion(*externalEonSdkAPI.NewRegionCondition(externalEonSdkAPI.IN_OPERATOR, []string{"us-east-1"}))
+	tagOrRegion.SetGroup(*externalEonSdkAPI.NewBackupPolicyGroupCondition(externalEonSdkAPI.OR_OPERATOR, []externalEonSdkAPI.BackupPolicyExpression{*tagOperand, *regionOperand}))
+
+	tagValueAndType := externalEonSdkAPI.NewBackupPolicyExpression()
+	tagValue := externalEonSdkAPI.NewTagKeyValue("team")
+	tagValue.SetValue("payments")
+	tagValueOperand := externalEonSdkAPI.NewBackupPolicyExpression()
+	tagValueOperand.SetTagKeyValues(*externalEonSdkAPI.NewTagKeyValuesCondition(externalEonSdkAPI.CONTAINS_ALL_OF_OPERATOR, []externalEonSdkAPI.TagKeyValue{*tagValue}))
+	typeOperand := externalEonSdkAPI.NewBackupPolicyExpression()
+	typeOperand.SetResourceType(*externalEonSdkAPI.NewResourceTypeCondition(externalEonSdkAPI.NOT_IN_OPERATOR, []externalEonSdkAPI.ResourceType{"AWS_RDS"}))
+	tagValueAndType.SetGroup(*externalEonSdkAPI.NewBackupPolicyGroupCondition(externalEonSdkAPI.AND_OPERATOR, []externalEonSdkAPI.BackupPolicyExpression{*tagValueOperand, *typeOperand}))
+
+	resourceGroup := externalEonSdkAPI.NewBackupPolicyExpression()
+	resourceGroup.SetResourceGroupName(*externalEonSdkAPI.NewResourceGroupNameCondition(externalEonSdkAPI.IN_OPERATOR, []string{"rg"}))
+
+	conditional := func(expression *externalEonSdkAPI.BackupPolicyExpression) *externalEonSdkAPI.BackupPolicyResourceSelector {
+		selector := externalEonSdkAPI.NewBackupPolicyResourceSelector(externalEonSdkAPI.RESOURCE_SELECTOR_MODE_CONDITIONAL)
+		if expression != nil {
+			selector.SetExpression(*expression)
+		}
+		return selector
+	}
+
+	tests := []struct {
+		name     string
+		selector *externalEonSdkAPI.BackupPolicyResourceSelector
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name:     "all",
+			selector: externalEonSdkAPI.NewBackupPolicyResourceSelector(externalEonSdkAPI.RESOURCE_SELECTOR_MODE_ALL),
+			expected: true,
+		},
+		{
+			name:     "none",
+			selector: externalEonSdkAPI.NewBackupPolicyResourceSelector(externalEonSdkAPI.RESOURCE_SELECTOR_MODE_NONE),
+			expected: false,
+		},
+		{
+			name: "none with inclusion override",
+			selector: &externalEonSdkAPI.BackupPolicyResourceSelector{
+				ResourceSelectionMode:     externalEonSdkAPI.RESOURCE_SELECTOR_MODE_NONE,
+				ResourceInclusionOverride: []string{"i-0123456789abcdef0"},
+			},
+			expected: true,
+		},
+		{
+			name: "all with exclusion override",
+			selector: &externalEonSdkAPI.BackupPolicyResourceSelector{
+				ResourceSelectionMode:     externalEonSdkAPI.RESOURCE_SELECTOR_MODE_ALL,
+				ResourceExclusionOverride: []string{"i-0123456789abcdef0"},
+			},
+			expected: false,
+		},
+		{
+			name:     "environment mismatch",
+			selector: conditional(prodOnly),
+			expected: false,
+		},
+		{
+			name:     "or group",
+			selector: conditional(tagOrRegion),
+			expected: true,
+		},
+		{
+			name:     "and group",
+			selector: conditional(tagValueAndType),
+			expected: true,
+		},
+		{
+			name:     "conditional without expression",
+			selector: conditional(nil),
+			wantErr:  true,
+		},
+		{
+			name:     "resource group not supported",
+			selector: conditional(resourceGroup),
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			matches, err := backupPolicySelectorMatches(tt.selector, resource)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, matches)
+		})
+	}
+}
+
+// TestListConditionMatches tests evaluation of list operators
+func TestListConditionMatches(t *testing.T) {
+	t.Parallel()
+
+	resourceValues := []string{"a", "b"}
+
+	tests := []struct {
+		operator string
+		values   []string
+		expected bool
+	}{
+		{"CONTAINS_ANY_OF", []string{"b", "c"}, true},
+		{"CONTAINS_ANY_OF", []string{"c"}, false},
+		{"CONTAINS_NONE_OF", []string{"c"}, true},
+		{"CONTAINS_NONE_OF", []string{"a"}, false},
+		{"CONTAINS_ALL_OF", []string{"a", "b"}, true},
+		{"CONTAINS_ALL_OF", []string{"a", "c"}, false},
+	}
+
+	for _, tt := range tests {
+		matches, err := listConditionMatches("test", tt.operator, tt.values, resourceValues)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expected, matches, "%s %v", tt.operator, tt.values)
+	}
+
+	_, err := listConditionMatches("test", "IN", []string{"a"}, resourceValues)
+	assert.Error(t, err)
+}
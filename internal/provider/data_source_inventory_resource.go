@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &InventoryResourceDataSource{}
+
+func NewInventoryResourceDataSource() datasource.DataSource {
+	return &InventoryResourceDataSource{}
+}
+
+type InventoryResourceDataSource struct {
+	client *client.EonClient
+}
+
+func (d *InventoryResourceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_resource"
+}
+
+func (d *InventoryResourceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves a single inventory resource in the Eon project, including its protection status and latest snapshot.",
+		Attributes: inventoryResourceAttributes(schema.StringAttribute{
+			MarkdownDescription: "Eon-assigned resource ID.",
+			Required:            true,
+		}),
+	}
+}
+
+func (d *InventoryResourceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.EonClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.EonClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InventoryResourceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InventoryResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Getting inventory resource", map[string]interface{}{
+		"resource_id": data.Id.ValueString(),
+	})
+
+	resource, err := d.client.GetResourceById(ctx, data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inventory resource, got error: %s", err))
+		return
+	}
+
+	latestSnapshotId := ""
+	if resource.LatestSnapshotTime != nil {
+		snapshot, err := d.client.GetLatestSnapshot(ctx, resource.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read latest snapshot for resource %s: %s", resource.Id, err))
+			return
+		}
+		if snapshot != nil {
+			latestSnapshotId = snapshot.Id
+		}
+	}
+
+	resourceModel, diags := newInventoryResourceModel(ctx, resource, latestSnapshotId)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &resourceModel)...)
+}
@@ -0,0 +1,609 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &InventoryResourcesDataSource{}
+
+func NewInventoryResourcesDataSource() datasource.DataSource {
+	return &InventoryResourcesDataSource{}
+}
+
+type InventoryResourcesDataSource struct {
+	client *client.EonClient
+}
+
+type InventoryResourcesDataSourceModel struct {
+	ResourceType          *ResourceTypeConditionModel  `tfsdk:"resource_type"`
+	Environment           *EnvironmentConditionModel   `tfsdk:"environment"`
+	DataClasses           *DataClassesConditionModel   `tfsdk:"data_classes"`
+	TagKeys               *TagKeysConditionModel       `tfsdk:"tag_keys"`
+	TagKeyValues          *TagKeyValuesConditionModel  `tfsdk:"tag_key_values"`
+	CloudProvider         *CloudProviderConditionModel `tfsdk:"cloud_provider"`
+	AccountId             *AccountIdConditionModel     `tfsdk:"account_id"`
+	SourceRegion          *SourceRegionConditionModel  `tfsdk:"source_region"`
+	IncludeLatestSnapshot types.Bool                   `tfsdk:"include_latest_snapshot"`
+	Resources             []InventoryResourceModel     `tfsdk:"resources"`
+}
+
+type InventoryResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	ProviderResourceId types.String `tfsdk:"provider_resource_id"`
+	Name               types.String `tfsdk:"name"`
+	ResourceType       types.String `tfsdk:"resource_type"`
+	CloudProvider      types.String `tfsdk:"cloud_provider"`
+	ProviderAccountId  types.String `tfsdk:"provider_account_id"`
+	Region             types.String `tfsdk:"region"`
+	Vpc                types.String `tfsdk:"vpc"`
+	Subnets            types.List   `tfsdk:"subnets"`
+	Tags               types.Map    `tfsdk:"tags"`
+	Environment        types.String `tfsdk:"environment"`
+	DataClasses        types.List   `tfsdk:"data_classes"`
+	BackupStatus       types.String `tfsdk:"backup_status"`
+	LatestSnapshotTime types.String `tfsdk:"latest_snapshot_time"`
+	OldestSnapshotTime types.String `tfsdk:"oldest_snapshot_time"`
+	LatestSnapshotId   types.String `tfsdk:"latest_snapshot_id"`
+}
+
+func (d *InventoryResourcesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_inventory_resources"
+}
+
+func (d *InventoryResourcesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves inventory resources in the Eon project, optionally filtered by the same conditions used in backup policy expressions. All conditions that are set must match.",
+		Attributes: map[string]schema.Attribute{
+			"resource_type": schema.SingleNestedAttribute{
+				MarkdownDescription: "Resource type condition.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator: `IN` or `NOT_IN`.",
+						Required:            true,
+					},
+					"resource_types": schema.ListAttribute{
+						MarkdownDescription: "List of resource types, such as `AWS_EC2` or `AWS_RDS`.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"environment": schema.SingleNestedAttribute{
+				MarkdownDescription: "Environment condition.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator: `IN` or `NOT_IN`.",
+						Required:            true,
+					},
+					"environments": schema.ListAttribute{
+						MarkdownDescription: "List of environments. Possible values: `PROD`, `PROD_INTERNAL`, `STAGE`, `ENVIRONMENT_UNSPECIFIED`.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"data_classes": schema.SingleNestedAttribute{
+				MarkdownDescription: "Data classes condition.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator: `CONTAINS_ANY_OF`, `CONTAINS_NONE_OF`, or `CONTAINS_ALL_OF`.",
+						Required:            true,
+					},
+					"data_classes": schema.ListAttribute{
+						MarkdownDescription: "List of data classes. Possible values: `FI`, `PHI`, `PII`.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"tag_keys": schema.SingleNestedAttribute{
+				MarkdownDescription: "Tag keys condition.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator: `CONTAINS_ANY_OF`, `CONTAINS_NONE_OF`, or `CONTAINS_ALL_OF`.",
+						Required:            true,
+					},
+					"tag_keys": schema.ListAttribute{
+						MarkdownDescription: "List of tag keys to match.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"tag_key_values": schema.SingleNestedAttribute{
+				MarkdownDescription: "Tag key-value pairs condition.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator: `CONTAINS_ANY_OF`, `CONTAINS_NONE_OF`, or `CONTAINS_ALL_OF`.",
+						Required:            true,
+					},
+					"tag_key_values": schema.ListNestedAttribute{
+						MarkdownDescription: "List of tag key-value pairs to match.",
+						Required:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"key": schema.StringAttribute{
+									MarkdownDescription: "Tag key.",
+									Required:            true,
+								},
+								"value": schema.StringAttribute{
+									MarkdownDescription: "Tag value.",
+									Required:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"cloud_provider": schema.SingleNestedAttribute{
+				MarkdownDescription: "Cloud provider condition.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator: `IN` or `NOT_IN`.",
+						Required:            true,
+					},
+					"cloud_providers": schema.ListAttribute{
+						MarkdownDescription: "List of cloud providers. Possible values: `AWS`, `AZURE`, `GCP`.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"account_id": schema.SingleNestedAttribute{
+				MarkdownDescription: "Cloud-provider-assigned account ID condition.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator: `IN` or `NOT_IN`.",
+						Required:            true,
+					},
+					"account_ids": schema.ListAttribute{
+						MarkdownDescription: "List of cloud-provider-assigned account IDs.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"source_region": schema.SingleNestedAttribute{
+				MarkdownDescription: "Source region condition.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"operator": schema.StringAttribute{
+						MarkdownDescription: "Operator: `IN` or `NOT_IN`.",
+						Required:            true,
+					},
+					"source_regions": schema.ListAttribute{
+						MarkdownDescription: "List of regions the resources are hosted in.",
+						ElementType:         types.StringType,
+						Required:            true,
+					},
+				},
+			},
+			"include_latest_snapshot": schema.BoolAttribute{
+				MarkdownDescription: "Whether to look up `latest_snapshot_id` for each matched resource. This makes one extra API call per resource that has been backed up. Defaults to `false`.",
+				Optional:            true,
+			},
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "List of matching inventory resources.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: inventoryResourceAttributes(schema.StringAttribute{
+						MarkdownDescription: "Eon-assigned resource ID.",
+						Computed:            true,
+					}),
+				},
+			},
+		},
+	}
+}
+
+// inventoryResourceAttributes returns the computed attributes describing an inventory resource
+func inventoryResourceAttributes(idAttribute schema.StringAttribute) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": idAttribute,
+		"provider_resource_id": schema.StringAttribute{
+			MarkdownDescription: "Cloud-provider-assigned resource ID.",
+			Computed:            true,
+		},
+		"name": schema.StringAttribute{
+			MarkdownDescription: "Resource display name.",
+			Computed:            true,
+		},
+		"resource_type": schema.StringAttribute{
+			MarkdownDescription: "Resource type, such as `AWS_EC2` or `AWS_RDS`.",
+			Computed:            true,
+		},
+		"cloud_provider": schema.StringAttribute{
+			MarkdownDescription: "Cloud provider. Possible values: `AWS`, `AZURE`, `GCP`.",
+			Computed:            true,
+		},
+		"provider_account_id": schema.StringAttribute{
+			MarkdownDescription: "Cloud-provider-assigned account ID the resource belongs to.",
+			Computed:            true,
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "Region the resource is hosted in.",
+			Computed:            true,
+		},
+		"vpc": schema.StringAttribute{
+			MarkdownDescription: "VPC the resource is in.",
+			Computed:            true,
+		},
+		"subnets": schema.ListAttribute{
+			MarkdownDescription: "List of subnets the resource belongs to.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"tags": schema.MapAttribute{
+			MarkdownDescription: "Resource tags as key-value pairs.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"environment": schema.StringAttribute{
+			MarkdownDescription: "Environment the resource is classified as.",
+			Computed:            true,
+		},
+		"data_classes": schema.ListAttribute{
+			MarkdownDescription: "Data classes detected in the resource.",
+			ElementType:         types.StringType,
+			Computed:            true,
+		},
+		"backup_status": schema.StringAttribute{
+			MarkdownDescription: "Protection status of the resource, such as `PROTECTED`, `NOT_BACKED_UP`, or `VIOLATIONS_DETECTED`.",
+			Computed:            true,
+		},
+		"latest_snapshot_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time of the resource's latest Eon snapshot.",
+			Computed:            true,
+		},
+		"oldest_snapshot_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time of the resource's first Eon snapshot.",
+			Computed:            true,
+		},
+		"latest_snapshot_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the resource's latest Eon snapshot. Can be passed to `eon_restore_job.snapshot_id`.",
+			Computed:            true,
+		},
+	}
+}
+
+func (d *InventoryResourcesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.EonClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.EonClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InventoryResourcesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InventoryResourcesDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, err := buildInventoryFilterConditions(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Invalid inventory filter: %s", err))
+		return
+	}
+
+	resources, err := d.client.ListResources(ctx, filters)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inventory resources: %s", err))
+		return
+	}
+
+	data.Resources = []InventoryResourceModel{}
+	for _, resource := range resources {
+		matches, err := inventoryResourceMatchesLocalFilters(ctx, &data, &resource)
+		if err != nil {
+			resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Invalid inventory filter: %s", err))
+			return
+		}
+		if !matches {
+			continue
+		}
+
+		latestSnapshotId := ""
+		if data.IncludeLatestSnapshot.ValueBool() && resource.LatestSnapshotTime != nil {
+			snapshot, err := d.client.GetLatestSnapshot(ctx, resource.Id)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read latest snapshot for resource %s: %s", resource.Id, err))
+				return
+			}
+			if snapshot != nil {
+				latestSnapshotId = snapshot.Id
+			}
+		}
+
+		resourceModel, diags := newInventoryResourceModel(ctx, &resource, latestSnapshotId)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		data.Resources = append(data.Resources, resourceModel)
+	}
+
+	tflog.Debug(ctx, "Read inventory resources", map[string]interface{}{
+		"count": len(data.Resources),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildInventoryFilterConditions converts the conditions supported by the inventory API into request filters.
+// Cloud provider and region conditions have no API filter and are applied by inventoryResourceMatchesLocalFilters.
+func buildInventoryFilterConditions(ctx context.Context, data *InventoryResourcesDataSourceModel) (*externalEonSdkAPI.InventoryFilterConditions, error) {
+	filters := externalEonSdkAPI.NewInventoryFilterConditions()
+
+	if data.ResourceType != nil {
+		var resourceTypeValues []string
+		if diags := data.ResourceType.ResourceTypes.ElementsAs(ctx, &resourceTypeValues, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse resource types list")
+		}
+
+		var resourceTypes []externalEonSdkAPI.ResourceType
+		for _, rt := range resourceTypeValues {
+			resourceTypes = append(resourceTypes, externalEonSdkAPI.ResourceType(rt))
+		}
+
+		resourceTypeFilters := externalEonSdkAPI.NewResourceTypeFilters()
+		switch externalEonSdkAPI.ScalarOperators(data.ResourceType.Operator.ValueString()) {
+		case externalEonSdkAPI.IN_OPERATOR:
+			resourceTypeFilters.SetIn(resourceTypes)
+		case externalEonSdkAPI.NOT_IN_OPERATOR:
+			resourceTypeFilters.SetNotIn(resourceTypes)
+		default:
+			return nil, fmt.Errorf("unsupported resource_type operator %q, must be IN or NOT_IN", data.ResourceType.Operator.ValueString())
+		}
+		filters.SetResourceType(*resourceTypeFilters)
+	}
+
+	if data.Environment != nil {
+		var environmentValues []string
+		if diags := data.Environment.Environments.ElementsAs(ctx, &environmentValues, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse environments list")
+		}
+
+		var environments []externalEonSdkAPI.Environment
+		for _, env := range environmentValues {
+			environments = append(environments, externalEonSdkAPI.Environment(env))
+		}
+
+		environmentFilters := externalEonSdkAPI.NewEnvironmentFilters()
+		switch externalEonSdkAPI.ScalarOperators(data.Environment.Operator.ValueString()) {
+		case externalEonSdkAPI.IN_OPERATOR:
+			environmentFilters.SetIn(environments)
+		case externalEonSdkAPI.NOT_IN_OPERATOR:
+			environmentFilters.SetNotIn(environments)
+		default:
+			return nil, fmt.Errorf("unsupported environment operator %q, must be IN or NOT_IN", data.Environment.Operator.ValueString())
+		}
+		filters.SetEnvironment(*environmentFilters)
+	}
+
+	if data.AccountId != nil {
+		var accountIds []string
+		if diags := data.AccountId.AccountIds.ElementsAs(ctx, &accountIds, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse account_ids list")
+		}
+
+		accountIdFilters := externalEonSdkAPI.NewAccountIdFilters()
+		switch externalEonSdkAPI.ScalarOperators(data.AccountId.Operator.ValueString()) {
+		case externalEonSdkAPI.IN_OPERATOR:
+			accountIdFilters.SetIn(accountIds)
+		case externalEonSdkAPI.NOT_IN_OPERATOR:
+			accountIdFilters.SetNotIn(accountIds)
+		default:
+			return nil, fmt.Errorf("unsupported account_id operator %q, must be IN or NOT_IN", data.AccountId.Operator.ValueString())
+		}
+		filters.SetAccountId(*accountIdFilters)
+	}
+
+	if data.DataClasses != nil {
+		var dataClasses []string
+		if diags := data.DataClasses.DataClasses.ElementsAs(ctx, &dataClasses, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse data_classes list")
+		}
+
+		var dataClassEnums []externalEonSdkAPI.DataClass
+		for _, dc := range dataClasses {
+			dataClassEnums = append(dataClassEnums, externalEonSdkAPI.DataClass(dc))
+		}
+
+		dataClassesFilters := externalEonSdkAPI.NewDataClassesFilters()
+		switch externalEonSdkAPI.ListOperators(data.DataClasses.Operator.ValueString()) {
+		case externalEonSdkAPI.CONTAINS_ANY_OF_OPERATOR:
+			dataClassesFilters.SetContainsAnyOf(dataClassEnums)
+		case externalEonSdkAPI.CONTAINS_NONE_OF_OPERATOR:
+			dataClassesFilters.SetContainsNoneOf(dataClassEnums)
+		case externalEonSdkAPI.CONTAINS_ALL_OF_OPERATOR:
+			dataClassesFilters.SetContainsAllOf(dataClasses)
+		default:
+			return nil, fmt.Errorf("unsupported data_classes operator %q, must be CONTAINS_ANY_OF, CONTAINS_NONE_OF or CONTAINS_ALL_OF", data.DataClasses.Operator.ValueString())
+		}
+		filters.SetDataClasses(*dataClassesFilters)
+	}
+
+	if data.TagKeys != nil {
+		var tagKeys []string
+		if diags := data.TagKeys.TagKeys.ElementsAs(ctx, &tagKeys, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse tag keys list")
+		}
+
+		tagKeysFilters := externalEonSdkAPI.NewTagKeysFilters()
+		switch externalEonSdkAPI.ListOperators(data.TagKeys.Operator.ValueString()) {
+		case externalEonSdkAPI.CONTAINS_ANY_OF_OPERATOR:
+			tagKeysFilters.SetContainsAnyOf(tagKeys)
+		case externalEonSdkAPI.CONTAINS_NONE_OF_OPERATOR:
+			tagKeysFilters.SetContainsNoneOf(tagKeys)
+		case externalEonSdkAPI.CONTAINS_ALL_OF_OPERATOR:
+			tagKeysFilters.SetContainsAllOf(tagKeys)
+		default:
+			return nil, fmt.Errorf("unsupported tag_keys operator %q, must be CONTAINS_ANY_OF, CONTAINS_NONE_OF or CONTAINS_ALL_OF", data.TagKeys.Operator.ValueString())
+		}
+		filters.SetTagKeys(*tagKeysFilters)
+	}
+
+	if data.TagKeyValues != nil {
+		var tagKeyValues []TagKeyValueModel
+		if diags := data.TagKeyValues.TagKeyValues.ElementsAs(ctx, &tagKeyValues, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse tag key-value list")
+		}
+
+		// The inventory API matches tags as "{key}={value}" strings.
+		var pairs []string
+		for _, kv := range tagKeyValues {
+			pairs = append(pairs, fmt.Sprintf("%s=%s", kv.Key.ValueString(), kv.Value.ValueString()))
+		}
+
+		tagKeyValuesFilters := externalEonSdkAPI.NewTagKeyValuesFilters()
+		switch externalEonSdkAPI.ListOperators(data.TagKeyValues.Operator.ValueString()) {
+		case externalEonSdkAPI.CONTAINS_ANY_OF_OPERATOR:
+			tagKeyValuesFilters.SetContainsAnyOf(pairs)
+		case externalEonSdkAPI.CONTAINS_NONE_OF_OPERATOR:
+			tagKeyValuesFilters.SetContainsNoneOf(pairs)
+		case externalEonSdkAPI.CONTAINS_ALL_OF_OPERATOR:
+			tagKeyValuesFilters.SetContainsAllOf(pairs)
+		default:
+			return nil, fmt.Errorf("unsupported tag_key_values operator %q, must be CONTAINS_ANY_OF, CONTAINS_NONE_OF or CONTAINS_ALL_OF", data.TagKeyValues.Operator.ValueString())
+		}
+		filters.SetTagKeyValues(*tagKeyValuesFilters)
+	}
+
+	return filters, nil
+}
+
+// inventoryResourceMatchesLocalFilters applies the cloud provider and region conditions, which the inventory API can't filter on
+func inventoryResourceMatchesLocalFilters(ctx context.Context, data *InventoryResourcesDataSourceModel, resource *externalEonSdkAPI.InventoryResource) (bool, error) {
+	if data.CloudProvider != nil {
+		var cloudProviders []string
+		if diags := data.CloudProvider.CloudProviders.ElementsAs(ctx, &cloudProviders, false); diags.HasError() {
+			return false, fmt.Errorf("failed to parse cloud_providers list")
+		}
+
+		matches, err := scalarConditionMatches("cloud_provider", data.CloudProvider.Operator.ValueString(), cloudProviders, string(resource.CloudProvider))
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	if data.SourceRegion != nil {
+		var sourceRegions []string
+		if diags := data.SourceRegion.SourceRegions.ElementsAs(ctx, &sourceRegions, false); diags.HasError() {
+			return false, fmt.Errorf("failed to parse source_regions list")
+		}
+
+		matches, err := scalarConditionMatches("source_region", data.SourceRegion.Operator.ValueString(), sourceRegions, resource.Region)
+		if err != nil || !matches {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// scalarConditionMatches evaluates an IN or NOT_IN condition against a single value
+func scalarConditionMatches(name, operator string, values []string, value string) (bool, error) {
+	switch externalEonSdkAPI.ScalarOperators(operator) {
+	case externalEonSdkAPI.IN_OPERATOR:
+		return slices.Contains(values, value), nil
+	case externalEonSdkAPI.NOT_IN_OPERATOR:
+		return !slices.Contains(values, value), nil
+	default:
+		return false, fmt.Errorf("unsupported %s operator %q, must be IN or NOT_IN", name, operator)
+	}
+}
+
+// newInventoryResourceModel converts an inventory resource returned by the API into its Terraform model
+func newInventoryResourceModel(ctx context.Context, resource *externalEonSdkAPI.InventoryResource, latestSnapshotId string) (InventoryResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := InventoryResourceModel{
+		Id:                 types.StringValue(resource.Id),
+		ProviderResourceId: types.StringValue(resource.ProviderResourceId),
+		Name:               types.StringValue(resource.ResourceName),
+		ResourceType:       types.StringValue(string(resource.ResourceType)),
+		CloudProvider:      types.StringValue(string(resource.CloudProvider)),
+		ProviderAccountId:  types.StringValue(resource.ProviderAccountId),
+		Region:             types.StringValue(resource.Region),
+		Vpc:                types.StringPointerValue(resource.Vpc),
+		BackupStatus:       types.StringValue(string(resource.BackupStatus)),
+		Environment:        types.StringNull(),
+		LatestSnapshotTime: types.StringNull(),
+		OldestSnapshotTime: types.StringNull(),
+		LatestSnapshotId:   types.StringNull(),
+	}
+
+	if resource.LatestSnapshotTime != nil {
+		model.LatestSnapshotTime = types.StringValue(resource.LatestSnapshotTime.Format(time.RFC3339))
+	}
+	if resource.OldestSnapshotTime != nil {
+		model.OldestSnapshotTime = types.StringValue(resource.OldestSnapshotTime.Format(time.RFC3339))
+	}
+	if latestSnapshotId != "" {
+		model.LatestSnapshotId = types.StringValue(latestSnapshotId)
+	}
+
+	subnets := resource.Subnets
+	if subnets == nil {
+		subnets = []string{}
+	}
+	subnetsList, d := types.ListValueFrom(ctx, types.StringType, subnets)
+	diags.Append(d...)
+	model.Subnets = subnetsList
+
+	tags := resource.Tags
+	if tags == nil {
+		tags = map[string]string{}
+	}
+	tagsMap, d := types.MapValueFrom(ctx, types.StringType, tags)
+	diags.Append(d...)
+	model.Tags = tagsMap
+
+	dataClasses := []string{}
+	if resource.Classifications != nil {
+		if envDetails := resource.Classifications.EnvironmentDetails; envDetails != nil && envDetails.Environment != nil {
+			model.Environment = types.StringValue(string(*envDetails.Environment))
+		}
+		if dcDetails := resource.Classifications.DataClassesDetails; dcDetails != nil {
+			for _, dc := range dcDetails.DataClasses {
+				dataClasses = append(dataClasses, string(dc))
+			}
+		}
+	}
+	dataClassesList, d := types.ListValueFrom(ctx, types.StringType, dataClasses)
+	diags.Append(d...)
+	model.DataClasses = dataClassesList
+
+	return model, diags
+}
@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func stringList(values ...string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+// TestInventoryDataSources_Unit tests the data source creation without API calls
+func TestInventoryDataSources_Unit(t *testing.T) {
+	t.Parallel()
+
+	assert.NotNil(t, NewInventoryResourcesDataSource(), "Data source should not be nil")
+	assert.NotNil(t, NewInventoryResourceDataSource(), "Data source should not be nil")
+}
+
+// TestBuildInventoryFilterConditions tests conversion of data source conditions into API filters
+func TestBuildInventoryFilterConditions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("no conditions", func(t *testing.T) {
+		t.Parallel()
+
+		filters, err := buildInventoryFilterConditions(ctx, &InventoryResourcesDataSourceModel{})
+		require.NoError(t, err)
+		assert.False(t, filters.HasResourceType())
+		assert.False(t, filters.HasEnvironment())
+		assert.False(t, filters.HasTagKeyValues())
+	})
+
+	t.Run("scalar and list conditions", func(t *testing.T) {
+		t.Parallel()
+
+		tagKeyValueType := types.ObjectType{AttrTypes: map[string]attr.Type{"key": types.StringType, "value": types.StringType}}
+		data := &InventoryResourcesDataSourceModel{
+			ResourceType: &ResourceTypeConditionModel{
+				Operator:      types.StringValue("IN"),
+				ResourceTypes: stringList("AWS_RDS", "AWS_EC2"),
+			},
+			Environment: &EnvironmentConditionModel{
+				Operator:     types.StringValue("NOT_IN"),
+				Environments: stringList("STAGE"),
+			},
+			DataClasses: &DataClassesConditionModel{
+				Operator:    types.StringValue("CONTAINS_ALL_OF"),
+				DataClasses: stringList("PII", "PHI"),
+			},
+			TagKeyValues: &TagKeyValuesConditionModel{
+				Operator: types.StringValue("CONTAINS_ANY_OF"),
+				TagKeyValues: types.ListValueMust(tagKeyValueType, []attr.Value{
+					types.ObjectValueMust(tagKeyValueType.AttrTypes, map[string]attr.Value{
+						"key":   types.StringValue("team"),
+						"value": types.StringValue("payments"),
+					}),
+				}),
+			},
+		}
+
+		filters, err := buildInventoryFilterConditions(ctx, data)
+		require.NoError(t, err)
+
+		resourceTypeFilters := filters.GetResourceType()
+		assert.Equal(t, []externalEonSdkAPI.ResourceType{"AWS_RDS", "AWS_EC2"}, resourceTypeFilters.In)
+		assert.Empty(t, resourceTypeFilters.NotIn)
+
+		environmentFilters := filters.GetEnvironment()
+		assert.Equal(t, []externalEonSdkAPI.Environment{"STAGE"}, environmentFilters.NotIn)
+
+		dataClassesFilters := filters.GetDataClasses()
+		assert.Equal(t, []string{"PII", "PHI"}, dataClassesFilters.ContainsAllOf)
+
+		tagKeyValuesFilters := filters.GetTagKeyValues()
+		assert.Equal(t, []string{"team=payments"}, tagKeyValuesFilters.ContainsAnyOf)
+	})
+
+	t.Run("invalid operator", func(t *testing.T) {
+		t.Parallel()
+
+		data := &InventoryResourcesDataSourceModel{
+			TagKeys: &TagKeysConditionModel{
+				Operator: types.StringValue("IN"),
+				TagKeys:  stringList("owner"),
+			},
+		}
+
+		_, err := buildInventoryFilterConditions(ctx, data)
+		assert.ErrorContains(t, err, "unsupported tag_keys operator")
+	})
+}
+
+// TestInventoryResourceMatchesLocalFilters tests the client-side cloud provider and region conditions
+func TestInventoryResourceMatchesLocalFilters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resource := &externalEonSdkAPI.InventoryResource{
+		CloudProvider: externalEonSdkAPI.AWS,
+		Region:        "us-east-1",
+	}
+
+	tests := []struct {
+		name     string
+		data     *InventoryResourcesDataSourceModel
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name:     "no conditions",
+			data:     &InventoryResourcesDataSourceModel{},
+			expected: true,
+		},
+		{
+			name: "provider in list",
+			data: &InventoryResourcesDataSourceModel{
+				CloudProvider: &CloudProviderConditionModel{Operator: types.StringValue("IN"), CloudProviders: stringList("AWS")},
+			},
+			expected: true,
+		},
+		{
+			name: "region excluded",
+			data: &InventoryResourcesDataSourceModel{
+				SourceRegion: &SourceRegionConditionModel{Operator: types.StringValue("NOT_IN"), SourceRegions: stringList("us-east-1")},
+			},
+			expected: false,
+		},
+		{
+			name: "unsupported operator",
+			data: &InventoryResourcesDataSourceModel{
+				SourceRegion: &SourceRegionConditionModel{Operator: types.StringValue("CONTAINS_ANY_OF"), SourceRegions: stringList("us-east-1")},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			matches, err := inventoryResourceMatchesLocalFilters(ctx, tt.data, resource)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, matches)
+		})
+	}
+}
+
+// TestNewInventoryResourceModel tests conversion of an API inventory resource into the Terraform model
+func TestNewInventoryResourceModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	latest := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	env := externalEonSdkAPI.PROD
+
+	resource := &externalEonSdkAPI.InventoryResource{
+		Id:                 "res-1",
+		ProviderResourceId: "i-0123456789abcdef0",
+		ResourceName:       "web-1",
+		ResourceType:       externalEonSdkAPI.ResourceType("AWS_EC2"),
+		CloudProvider:      externalEonSdkAPI.AWS,
+		BackupStatus:       externalEonSdkAPI.PROTECTED,
+		Region:             "us-east-1",
+		LatestSnapshotTime: &latest,
+		Tags:               map[string]string{"env": "prod"},
+		Classifications: &externalEonSdkAPI.Classifications{
+			EnvironmentDetails: &externalEonSdkAPI.EnvironmentDetails{Environment: &env},
+		},
+	}
+
+	model, diags := newInventoryResourceModel(ctx, resource, "snap-1")
+	require.False(t, diags.HasError())
+
+	assert.Equal(t, "res-1", model.Id.ValueString())
+	assert.Equal(t, "PROTECTED", model.BackupStatus.ValueString())
+	assert.Equal(t, "PROD", model.Environment.ValueString())
+	assert.Equal(t, "2025-06-01T12:00:00Z", model.LatestSnapshotTime.ValueString())
+	assert.True(t, model.OldestSnapshotTime.IsNull())
+	assert.True(t, model.Vpc.IsNull())
+	assert.Equal(t, "snap-1", model.LatestSnapshotId.ValueString())
+	assert.Len(t, model.Subnets.Elements(), 0)
+	assert.Len(t, model.Tags.Elements(), 1)
+}
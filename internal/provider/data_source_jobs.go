@@ -0,0 +1,377 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// JobFiltersModel holds the filters shared by the eon_restore_jobs and eon_backup_jobs data sources
+type JobFiltersModel struct {
+	Statuses            types.List   `tfsdk:"statuses"`
+	ResourceIds         types.List   `tfsdk:"resource_ids"`
+	ProviderResourceIds types.List   `tfsdk:"provider_resource_ids"`
+	SnapshotIds         types.List   `tfsdk:"snapshot_ids"`
+	BackupPolicyId      types.String `tfsdk:"backup_policy_id"`
+	StartedAfter        types.String `tfsdk:"started_after"`
+	StartedBefore       types.String `tfsdk:"started_before"`
+	MaxResults          types.Int64  `tfsdk:"max_results"`
+}
+
+// JobExecutionModel holds the execution details shared by restore and backup jobs
+type JobExecutionModel struct {
+	JobId               types.String `tfsdk:"job_id"`
+	Status              types.String `tfsdk:"status"`
+	StatusMessage       types.String `tfsdk:"status_message"`
+	CreatedTime         types.String `tfsdk:"created_time"`
+	ExpectedStartTime   types.String `tfsdk:"expected_start_time"`
+	StartTime           types.String `tfsdk:"start_time"`
+	EndTime             types.String `tfsdk:"end_time"`
+	DurationSeconds     types.Int64  `tfsdk:"duration_seconds"`
+	ResourceId          types.String `tfsdk:"resource_id"`
+	ProviderResourceId  types.String `tfsdk:"provider_resource_id"`
+	ResourceName        types.String `tfsdk:"resource_name"`
+	ResourceType        types.String `tfsdk:"resource_type"`
+	SnapshotId          types.String `tfsdk:"snapshot_id"`
+	SnapshotPointInTime types.String `tfsdk:"snapshot_point_in_time"`
+	VaultId             types.String `tfsdk:"vault_id"`
+}
+
+// jobFilterAttributes returns the optional filter attributes shared by the job history data sources
+func jobFilterAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"statuses": schema.ListAttribute{
+			MarkdownDescription: "Only return jobs with these statuses. Possible values: `JOB_PENDING`, `JOB_RUNNING`, `JOB_COMPLETED`, `JOB_FAILED`, `JOB_PARTIAL`, `JOB_CANCELLED`.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"resource_ids": schema.ListAttribute{
+			MarkdownDescription: "Only return jobs for these Eon-assigned resource IDs. Each resource is looked up in the inventory, so that Eon filters the jobs by its cloud-provider-assigned ID.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"provider_resource_ids": schema.ListAttribute{
+			MarkdownDescription: "Only return jobs for these cloud-provider-assigned resource IDs.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"snapshot_ids": schema.ListAttribute{
+			MarkdownDescription: "Only return jobs for these Eon snapshot IDs.",
+			ElementType:         types.StringType,
+			Optional:            true,
+		},
+		"backup_policy_id": schema.StringAttribute{
+			MarkdownDescription: "Only return jobs for resources the backup policy currently matches. The policy's `resource_selector` is evaluated against the current inventory, so jobs for resources that have since left the policy aren't returned.",
+			Optional:            true,
+		},
+		"started_after": schema.StringAttribute{
+			MarkdownDescription: "Only return jobs that started at or after this time, in RFC 3339 format. Jobs that haven't started yet are excluded.",
+			Optional:            true,
+		},
+		"started_before": schema.StringAttribute{
+			MarkdownDescription: "Only return jobs that started at or before this time, in RFC 3339 format. Jobs that haven't started yet are excluded.",
+			Optional:            true,
+		},
+		"max_results": schema.Int64Attribute{
+			MarkdownDescription: "Maximum number of jobs to return. The newest matching jobs are returned, and the rest of the job history isn't read. Defaults to all matching jobs.",
+			Optional:            true,
+			Validators:          []validator.Int64{int64validator.AtLeast(1)},
+		},
+	}
+}
+
+// jobExecutionAttributes returns the computed attributes describing a job's execution, shared by restore and backup jobs
+func jobExecutionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"job_id": schema.StringAttribute{
+			MarkdownDescription: "Eon job ID.",
+			Computed:            true,
+		},
+		"status": schema.StringAttribute{
+			MarkdownDescription: "Job status, such as `JOB_RUNNING`, `JOB_COMPLETED`, or `JOB_FAILED`.",
+			Computed:            true,
+		},
+		"status_message": schema.StringAttribute{
+			MarkdownDescription: "Additional information about the job status, such as the reason a job failed.",
+			Computed:            true,
+		},
+		"created_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time the job was created.",
+			Computed:            true,
+		},
+		"expected_start_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time the job is expected to start.",
+			Computed:            true,
+		},
+		"start_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time the job started.",
+			Computed:            true,
+		},
+		"end_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time the job ended.",
+			Computed:            true,
+		},
+		"duration_seconds": schema.Int64Attribute{
+			MarkdownDescription: "Job duration, in seconds.",
+			Computed:            true,
+		},
+		"resource_id": schema.StringAttribute{
+			MarkdownDescription: "Eon-assigned ID of the job's resource.",
+			Computed:            true,
+		},
+		"provider_resource_id": schema.StringAttribute{
+			MarkdownDescription: "Cloud-provider-assigned ID of the job's resource.",
+			Computed:            true,
+		},
+		"resource_name": schema.StringAttribute{
+			MarkdownDescription: "Display name of the job's resource.",
+			Computed:            true,
+		},
+		"resource_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the job's resource, such as `AWS_EC2` or `AWS_RDS`.",
+			Computed:            true,
+		},
+		"snapshot_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the Eon snapshot the job created or restored from.",
+			Computed:            true,
+		},
+		"snapshot_point_in_time": schema.StringAttribute{
+			MarkdownDescription: "Date and time the snapshot was taken.",
+			Computed:            true,
+		},
+		"vault_id": schema.StringAttribute{
+			MarkdownDescription: "ID of the vault the snapshot is stored in.",
+			Computed:            true,
+		},
+	}
+}
+
+// jobFilters holds the parsed job history filters. The API filters are sent with the list request, while
+// resourceIds and the exact start time bounds are also applied locally since the API can only filter those by
+// provider resource ID and by date.
+type jobFilters struct {
+	status             *externalEonSdkAPI.JobStatusFilters
+	providerResourceId *externalEonSdkAPI.ResourceIdFilters
+	snapshotId         *externalEonSdkAPI.SnapshotIdFilters
+	startTime          *externalEonSdkAPI.StartTimeDateFilters
+
+	resourceIds    []string
+	startedAfter   *time.Time
+	startedBefore  *time.Time
+	matchesNothing bool
+}
+
+// buildJobFilters parses the filters shared by the job history data sources
+func buildJobFilters(ctx context.Context, data *JobFiltersModel) (*jobFilters, error) {
+	filters := &jobFilters{}
+
+	if !data.Statuses.IsNull() {
+		var statusValues []string
+		if diags := data.Statuses.ElementsAs(ctx, &statusValues, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse statuses list")
+		}
+
+		var statuses []externalEonSdkAPI.JobStatus
+		for _, status := range statusValues {
+			jobStatus, err := externalEonSdkAPI.NewJobStatusFromValue(status)
+			if err != nil {
+				return nil, fmt.Errorf("unsupported status %q", status)
+			}
+			statuses = append(statuses, *jobStatus)
+		}
+		filters.status = &externalEonSdkAPI.JobStatusFilters{In: statuses}
+	}
+
+	if !data.ResourceIds.IsNull() {
+		if diags := data.ResourceIds.ElementsAs(ctx, &filters.resourceIds, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse resource_ids list")
+		}
+	}
+
+	if !data.ProviderResourceIds.IsNull() {
+		var providerResourceIds []string
+		if diags := data.ProviderResourceIds.ElementsAs(ctx, &providerResourceIds, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse provider_resource_ids list")
+		}
+		filters.providerResourceId = &externalEonSdkAPI.ResourceIdFilters{In: providerResourceIds}
+	}
+
+	if !data.SnapshotIds.IsNull() {
+		var snapshotIds []string
+		if diags := data.SnapshotIds.ElementsAs(ctx, &snapshotIds, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse snapshot_ids list")
+		}
+		filters.snapshotId = &externalEonSdkAPI.SnapshotIdFilters{In: snapshotIds}
+	}
+
+	// The API filters start times by UTC date, so it returns a superset that matches() narrows down to the exact bounds.
+	if !data.StartedAfter.IsNull() {
+		startedAfter, err := time.Parse(time.RFC3339, data.StartedAfter.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid started_after %q, expected RFC 3339 format such as 2024-01-02T15:04:05Z: %s", data.StartedAfter.ValueString(), err)
+		}
+		filters.startedAfter = &startedAfter
+		filters.startTime = externalEonSdkAPI.NewStartTimeDateFilters()
+		filters.startTime.SetStartDate(startedAfter.UTC().Format(time.DateOnly))
+	}
+
+	if !data.StartedBefore.IsNull() {
+		startedBefore, err := time.Parse(time.RFC3339, data.StartedBefore.ValueString())
+		if err != nil {
+			return nil, fmt.Errorf("invalid started_before %q, expected RFC 3339 format such as 2024-01-02T15:04:05Z: %s", data.StartedBefore.ValueString(), err)
+		}
+		if filters.startedAfter != nil && startedBefore.Before(*filters.startedAfter) {
+			return nil, fmt.Errorf("started_before %q is earlier than started_after %q", data.StartedBefore.ValueString(), data.StartedAfter.ValueString())
+		}
+		filters.startedBefore = &startedBefore
+		if filters.startTime == nil {
+			filters.startTime = externalEonSdkAPI.NewStartTimeDateFilters()
+		}
+		filters.startTime.SetEndDate(startedBefore.UTC().Format(time.DateOnly))
+	}
+
+	return filters, nil
+}
+
+// restrictJobFiltersToResources limits the provider resource ID filter to the resources in resource_ids, so that
+// the API only returns their jobs
+func restrictJobFiltersToResources(ctx context.Context, c *client.EonClient, filters *jobFilters) error {
+	if filters.resourceIds == nil {
+		return nil
+	}
+
+	providerResourceIds := make([]string, 0, len(filters.resourceIds))
+	for _, resourceId := range filters.resourceIds {
+		resource, err := c.GetResourceById(ctx, resourceId)
+		if err != nil {
+			return fmt.Errorf("resource %s: %w", resourceId, err)
+		}
+		providerResourceIds = append(providerResourceIds, resource.ProviderResourceId)
+	}
+
+	filters.restrictProviderResourceIds(providerResourceIds)
+	return nil
+}
+
+// restrictJobFiltersToBackupPolicy limits the provider resource ID filter to the resources the configured backup
+// policy currently matches. If no resources match, the filters match nothing.
+func restrictJobFiltersToBackupPolicy(ctx context.Context, c *client.EonClient, data *JobFiltersModel, filters *jobFilters) error {
+	if data.BackupPolicyId.IsNull() || data.BackupPolicyId.ValueString() == "" {
+		return nil
+	}
+
+	policy, err := c.GetBackupPolicy(ctx, data.BackupPolicyId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	resources, err := c.ListResources(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	var providerResourceIds []string
+	for _, resource := range resources {
+		matches, err := backupPolicySelectorMatches(&policy.ResourceSelector, &resource)
+		if err != nil {
+			return fmt.Errorf("unable to evaluate resource_selector: %w", err)
+		}
+		if matches {
+			providerResourceIds = append(providerResourceIds, resource.ProviderResourceId)
+		}
+	}
+
+	filters.restrictProviderResourceIds(providerResourceIds)
+	return nil
+}
+
+// restrictProviderResourceIds intersects the provider resource ID filter with the given IDs
+func (f *jobFilters) restrictProviderResourceIds(providerResourceIds []string) {
+	if f.providerResourceId != nil {
+		providerResourceIds = slices.DeleteFunc(providerResourceIds, func(id string) bool {
+			return !slices.Contains(f.providerResourceId.In, id)
+		})
+	}
+
+	// An empty IN filter would be dropped from the request and match every job.
+	if len(providerResourceIds) == 0 {
+		f.matchesNothing = true
+	}
+	f.providerResourceId = &externalEonSdkAPI.ResourceIdFilters{In: providerResourceIds}
+}
+
+// matches applies the filters the API can't evaluate exactly to a job returned by the API
+func (f *jobFilters) matches(resource externalEonSdkAPI.ResourceDetails, execution externalEonSdkAPI.JobExecutionDetails) bool {
+	if f.resourceIds != nil && !slices.Contains(f.resourceIds, resource.Id) {
+		return false
+	}
+
+	if f.startedAfter == nil && f.startedBefore == nil {
+		return true
+	}
+
+	startTime := execution.StartTime.Get()
+	if startTime == nil {
+		return false
+	}
+	if f.startedAfter != nil && startTime.Before(*f.startedAfter) {
+		return false
+	}
+	if f.startedBefore != nil && startTime.After(*f.startedBefore) {
+		return false
+	}
+	return true
+}
+
+// newJobExecutionModel converts the execution, resource and snapshot details of a job into their Terraform model
+func newJobExecutionModel(execution externalEonSdkAPI.JobExecutionDetails, resource *externalEonSdkAPI.ResourceDetails, snapshot *externalEonSdkAPI.JobSnapshotDetails, vault *externalEonSdkAPI.BackupVault) JobExecutionModel {
+	model := JobExecutionModel{
+		JobId:               types.StringValue(execution.JobId),
+		Status:              types.StringValue(string(execution.Status)),
+		StatusMessage:       types.StringPointerValue(execution.StatusMessage),
+		CreatedTime:         types.StringValue(execution.CreatedTime.Format(time.RFC3339)),
+		ExpectedStartTime:   optionalTimeValue(execution.ExpectedStartTime.Get()),
+		StartTime:           optionalTimeValue(execution.StartTime.Get()),
+		EndTime:             optionalTimeValue(execution.EndTime.Get()),
+		DurationSeconds:     types.Int64PointerValue(execution.DurationSeconds.Get()),
+		ResourceId:          types.StringNull(),
+		ProviderResourceId:  types.StringNull(),
+		ResourceName:        types.StringNull(),
+		ResourceType:        types.StringNull(),
+		SnapshotId:          types.StringNull(),
+		SnapshotPointInTime: types.StringNull(),
+		VaultId:             types.StringNull(),
+	}
+
+	if resource != nil {
+		model.ResourceId = types.StringValue(resource.Id)
+		model.ProviderResourceId = types.StringValue(resource.ProviderResourceId)
+		model.ResourceName = types.StringValue(resource.ResourceName)
+		model.ResourceType = types.StringValue(string(resource.ResourceType))
+	}
+	if snapshot != nil {
+		model.SnapshotId = types.StringPointerValue(snapshot.Id)
+		model.SnapshotPointInTime = types.StringValue(snapshot.PointInTime.Format(time.RFC3339))
+	}
+	if vault != nil {
+		model.VaultId = types.StringValue(vault.Id)
+	}
+
+	return model
+}
+
+// optionalTimeValue formats an optional API timestamp as an RFC 3339 string
+func optionalTimeValue(value *time.Time) types.String {
+	if value == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(value.Format(time.RFC3339))
+}
@@ -0,0 +1,132 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestJobDataSourceSchemas tests that the job history data sources have valid schemas
+func TestJobDataSourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	for _, dataSource := range []datasource.DataSource{NewRestoreJobsDataSource(), NewBackupJobsDataSource()} {
+		resp := &datasource.SchemaResponse{}
+		dataSource.Schema(ctx, datasource.SchemaRequest{}, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		assert.False(t, resp.Schema.ValidateImplementation(ctx).HasError())
+		assert.Contains(t, resp.Schema.Attributes, "backup_policy_id")
+		assert.Contains(t, resp.Schema.Attributes, "max_results")
+		assert.Contains(t, resp.Schema.Attributes, "jobs")
+	}
+}
+
+// TestBuildJobFilters tests parsing the filters shared by the job history data sources
+func TestBuildJobFilters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("no filters", func(t *testing.T) {
+		t.Parallel()
+
+		filters, err := buildJobFilters(ctx, &JobFiltersModel{})
+		require.NoError(t, err)
+		assert.Nil(t, filters.status)
+		assert.Nil(t, filters.providerResourceId)
+		assert.Nil(t, filters.startTime)
+		assert.False(t, filters.matchesNothing)
+	})
+
+	t.Run("api filters", func(t *testing.T) {
+		t.Parallel()
+
+		filters, err := buildJobFilters(ctx, &JobFiltersModel{
+			Statuses:            stringList("JOB_FAILED", "JOB_PARTIAL"),
+			ProviderResourceIds: stringList("i-1"),
+			SnapshotIds:         stringList("snap-1"),
+			StartedAfter:        types.StringValue("2024-01-02T23:30:00-02:00"),
+			StartedBefore:       types.StringValue("2024-01-05T10:00:00Z"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []externalEonSdkAPI.JobStatus{externalEonSdkAPI.JOB_FAILED, externalEonSdkAPI.JOB_PARTIAL}, filters.status.In)
+		assert.Equal(t, []string{"i-1"}, filters.providerResourceId.In)
+		assert.Equal(t, []string{"snap-1"}, filters.snapshotId.In)
+		assert.Equal(t, "2024-01-03", filters.startTime.GetStartDate())
+		assert.Equal(t, "2024-01-05", filters.startTime.GetEndDate())
+	})
+
+	tests := []struct {
+		name  string
+		data  JobFiltersModel
+		error string
+	}{
+		{"unknown status", JobFiltersModel{Statuses: stringList("DONE")}, `unsupported status "DONE"`},
+		{"invalid time", JobFiltersModel{StartedAfter: types.StringValue("yesterday")}, "invalid started_after"},
+		{"inverted range", JobFiltersModel{
+			StartedAfter:  types.StringValue("2024-01-02T00:00:00Z"),
+			StartedBefore: types.StringValue("2024-01-01T00:00:00Z"),
+		}, "is earlier than started_after"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := buildJobFilters(ctx, &tt.data)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.error)
+		})
+	}
+}
+
+// TestJobFiltersRestrictProviderResourceIds tests limiting job filters to the resources of a backup policy
+func TestJobFiltersRestrictProviderResourceIds(t *testing.T) {
+	t.Parallel()
+
+	filters := &jobFilters{}
+	filters.restrictProviderResourceIds([]string{"i-1", "i-2"})
+	assert.Equal(t, []string{"i-1", "i-2"}, filters.providerResourceId.In)
+	assert.False(t, filters.matchesNothing)
+
+	filters.restrictProviderResourceIds([]string{"i-2", "i-3"})
+	assert.Equal(t, []string{"i-2"}, filters.providerResourceId.In)
+	assert.False(t, filters.matchesNothing)
+
+	filters.restrictProviderResourceIds([]string{"i-3"})
+	assert.True(t, filters.matchesNothing)
+}
+
+// TestJobFiltersMatches tests the filters applied locally to jobs returned by the API
+func TestJobFiltersMatches(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	filters, err := buildJobFilters(ctx, &JobFiltersModel{
+		ResourceIds:   stringList("resource-1"),
+		StartedAfter:  types.StringValue("2024-01-02T12:00:00Z"),
+		StartedBefore: types.StringValue("2024-01-02T18:00:00Z"),
+	})
+	require.NoError(t, err)
+
+	execution := func(startTime *time.Time) externalEonSdkAPI.JobExecutionDetails {
+		details := externalEonSdkAPI.JobExecutionDetails{JobId: "job-1"}
+		details.StartTime.Set(startTime)
+		return details
+	}
+	inRange := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	tooEarly := time.Date(2024, 1, 2, 6, 0, 0, 0, time.UTC)
+
+	resource := externalEonSdkAPI.ResourceDetails{Id: "resource-1"}
+	assert.True(t, filters.matches(resource, execution(&inRange)))
+	assert.False(t, filters.matches(resource, execution(&tooEarly)))
+	assert.False(t, filters.matches(resource, execution(nil)))
+	assert.False(t, filters.matches(externalEonSdkAPI.ResourceDetails{Id: "resource-2"}, execution(&inRange)))
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &RestoreJobsDataSource{}
+
+func NewRestoreJobsDataSource() datasource.DataSource {
+	return &RestoreJobsDataSource{}
+}
+
+type RestoreJobsDataSource struct {
+	client *client.EonClient
+}
+
+type RestoreJobsDataSourceModel struct {
+	JobFiltersModel
+	RestoreTypes types.List          `tfsdk:"restore_types"`
+	Jobs         []RestoreJobSummary `tfsdk:"jobs"`
+}
+
+type RestoreJobSummary struct {
+	JobExecutionModel
+	RestoreType      types.String `tfsdk:"restore_type"`
+	RestoreAccountId types.String `tfsdk:"restore_account_id"`
+	RestoreRegion    types.String `tfsdk:"restore_region"`
+}
+
+func (d *RestoreJobsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restore_jobs"
+}
+
+func (d *RestoreJobsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := jobFilterAttributes()
+	attributes["restore_types"] = schema.ListAttribute{
+		MarkdownDescription: "Only return jobs of these restore types, such as `AWS_EC2_EBS_VOLUME_RESTORE` or `AWS_RDS_INSTANCE_RESTORE`.",
+		ElementType:         types.StringType,
+		Optional:            true,
+	}
+
+	jobAttributes := jobExecutionAttributes()
+	jobAttributes["restore_type"] = schema.StringAttribute{
+		MarkdownDescription: "Restore type, such as `AWS_EC2_EBS_VOLUME_RESTORE`.",
+		Computed:            true,
+	}
+	jobAttributes["restore_account_id"] = schema.StringAttribute{
+		MarkdownDescription: "Eon-assigned ID of the restore account the resource was restored to.",
+		Computed:            true,
+	}
+	jobAttributes["restore_region"] = schema.StringAttribute{
+		MarkdownDescription: "Region the resource was restored to.",
+		Computed:            true,
+	}
+	attributes["jobs"] = schema.ListNestedAttribute{
+		MarkdownDescription: "List of matching restore jobs, newest first.",
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: jobAttributes,
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Retrieves the history of restore jobs in the Eon project, optionally filtered by status, resource, backup policy, restore type and start time. All filters that are set must match.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *RestoreJobsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.EonClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.EonClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RestoreJobsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RestoreJobsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filters, err := buildJobFilters(ctx, &data.JobFiltersModel)
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Invalid restore job filter: %s", err))
+		return
+	}
+
+	if err := restrictJobFiltersToResources(ctx, d.client, filters); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resource_ids: %s", err))
+		return
+	}
+
+	if err := restrictJobFiltersToBackupPolicy(ctx, d.client, &data.JobFiltersModel, filters); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read resources of backup policy %s: %s", data.BackupPolicyId.ValueString(), err))
+		return
+	}
+
+	apiFilters, err := buildRestoreJobsFilterConditions(ctx, &data, filters)
+	if err != nil {
+		resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Invalid restore job filter: %s", err))
+		return
+	}
+
+	data.Jobs = []RestoreJobSummary{}
+	if !filters.matchesNothing {
+		jobs, err := d.client.ListRestoreJobs(ctx, apiFilters, int(data.MaxResults.ValueInt64()), func(job *externalEonSdkAPI.RestoreJob) bool {
+			return filters.matches(job.GetResourceDetails(), job.JobExecutionDetails)
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore jobs: %s", err))
+			return
+		}
+
+		for _, job := range jobs {
+			data.Jobs = append(data.Jobs, newRestoreJobSummary(&job))
+		}
+	}
+
+	tflog.Debug(ctx, "Read restore jobs", map[string]interface{}{
+		"count": len(data.Jobs),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildRestoreJobsFilterConditions converts the parsed filters and restore types into restore job API filters
+func buildRestoreJobsFilterConditions(ctx context.Context, data *RestoreJobsDataSourceModel, filters *jobFilters) (*externalEonSdkAPI.RestoreJobsFilterConditions, error) {
+	conditions := &externalEonSdkAPI.RestoreJobsFilterConditions{
+		Status:             filters.status,
+		ProviderResourceId: filters.providerResourceId,
+		SnapshotId:         filters.snapshotId,
+		StartTime:          filters.startTime,
+	}
+
+	if !data.RestoreTypes.IsNull() {
+		var restoreTypeValues []string
+		if diags := data.RestoreTypes.ElementsAs(ctx, &restoreTypeValues, false); diags.HasError() {
+			return nil, fmt.Errorf("failed to parse restore_types list")
+		}
+
+		var restoreTypes []externalEonSdkAPI.RestoreJobType
+		for _, value := range restoreTypeValues {
+			restoreType, err := externalEonSdkAPI.NewRestoreJobTypeFromValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("unsupported restore type %q", value)
+			}
+			restoreTypes = append(restoreTypes, *restoreType)
+		}
+		conditions.RestoreType = &externalEonSdkAPI.RestoreJobTypeFilters{In: restoreTypes}
+	}
+
+	return conditions, nil
+}
+
+// newRestoreJobSummary converts a restore job returned by the API into its Terraform model
+func newRestoreJobSummary(job *externalEonSdkAPI.RestoreJob) RestoreJobSummary {
+	return RestoreJobSummary{
+		JobExecutionModel: newJobExecutionModel(job.JobExecutionDetails, job.ResourceDetails.Get(), job.SnapshotDetails.Get(), job.Vault.Get()),
+		RestoreType:       types.StringValue(string(job.RestoreType)),
+		RestoreAccountId:  types.StringValue(job.DestinationDetails.RestoreAccountId),
+		RestoreRegion:     types.StringValue(job.DestinationDetails.Region),
+	}
+}
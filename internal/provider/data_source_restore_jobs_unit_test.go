@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestBuildJobTypeFilterConditions tests converting restore and backup types into API filters
+func TestBuildJobTypeFilterConditions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	restoreConditions, err := buildRestoreJobsFilterConditions(ctx, &RestoreJobsDataSourceModel{RestoreTypes: stringList("AWS_RDS_INSTANCE_RESTORE")}, &jobFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, []externalEonSdkAPI.RestoreJobType{externalEonSdkAPI.AWS_RDS_INSTANCE_RESTORE}, restoreConditions.RestoreType.In)
+
+	_, err = buildRestoreJobsFilterConditions(ctx, &RestoreJobsDataSourceModel{RestoreTypes: stringList("AWS_RDS_BACKUP")}, &jobFilters{})
+	assert.ErrorContains(t, err, `unsupported restore type "AWS_RDS_BACKUP"`)
+
+	backupConditions, err := buildBackupJobsFilterConditions(ctx, &BackupJobsDataSourceModel{BackupTypes: stringList("AWS_RDS_BACKUP")}, &jobFilters{})
+	require.NoError(t, err)
+	assert.Equal(t, []externalEonSdkAPI.BackupJobType{externalEonSdkAPI.AWS_RDS_BACKUP}, backupConditions.BackupType.In)
+	assert.Nil(t, backupConditions.Status)
+}
+
+// TestNewJobSummaries tests converting jobs returned by the API into their Terraform models
+func TestNewJobSummaries(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	duration := int64(90)
+	snapshotId := "snap-1"
+
+	backupJob := externalEonSdkAPI.BackupJob{
+		JobExecutionDetails: externalEonSdkAPI.JobExecutionDetails{
+			JobId:       "job-1",
+			Status:      externalEonSdkAPI.JOB_COMPLETED,
+			CreatedTime: created,
+		},
+		BackupType: externalEonSdkAPI.AWS_RDS_BACKUP,
+	}
+	backupJob.JobExecutionDetails.StartTime.Set(&created)
+	backupJob.JobExecutionDetails.DurationSeconds.Set(&duration)
+	backupJob.ResourceDetails.Set(&externalEonSdkAPI.ResourceDetails{Id: "resource-1", ProviderResourceId: "db-1", ResourceType: externalEonSdkAPI.AWS_RDS})
+	backupJob.SnapshotDetails.Set(&externalEonSdkAPI.JobSnapshotDetails{Id: &snapshotId, PointInTime: created})
+
+	backup := newBackupJobSummary(&backupJob)
+	assert.Equal(t, "job-1", backup.JobId.ValueString())
+	assert.Equal(t, "JOB_COMPLETED", backup.Status.ValueString())
+	assert.Equal(t, "2024-01-02T15:00:00Z", backup.StartTime.ValueString())
+	assert.True(t, backup.EndTime.IsNull())
+	assert.Equal(t, int64(90), backup.DurationSeconds.ValueInt64())
+	assert.Equal(t, "resource-1", backup.ResourceId.ValueString())
+	assert.Equal(t, "AWS_RDS", backup.ResourceType.ValueString())
+	assert.Equal(t, "snap-1", backup.SnapshotId.ValueString())
+	assert.True(t, backup.VaultId.IsNull())
+	assert.Equal(t, "AWS_RDS_BACKUP", backup.BackupType.ValueString())
+
+	restore := newRestoreJobSummary(&externalEonSdkAPI.RestoreJob{
+		JobExecutionDetails: externalEonSdkAPI.JobExecutionDetails{JobId: "job-2", CreatedTime: created},
+		DestinationDetails:  externalEonSdkAPI.DestinationDetails{RestoreAccountId: "account-1", Region: "us-west-2"},
+		RestoreType:         externalEonSdkAPI.AWS_RDS_INSTANCE_RESTORE,
+	})
+	assert.Equal(t, "job-2", restore.JobId.ValueString())
+	assert.True(t, restore.ResourceId.IsNull())
+	assert.True(t, restore.StartTime.IsNull())
+	assert.Equal(t, "account-1", restore.RestoreAccountId.ValueString())
+	assert.Equal(t, "us-west-2", restore.RestoreRegion.ValueString())
+	assert.Equal(t, "AWS_RDS_INSTANCE_RESTORE", restore.RestoreType.ValueString())
+}
@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/eon-io/terraform-provider-eon/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -30,6 +33,9 @@ type EonProviderModel struct {
 	ClientId     types.String `tfsdk:"client_id"`
 	ClientSecret types.String `tfsdk:"client_secret"`
 	ProjectId    types.String `tfsdk:"project_id"`
+
+	PollInterval    types.String `tfsdk:"poll_interval"`
+	MaxPollInterval types.String `tfsdk:"max_poll_interval"`
 }
 
 // New creates a new provider instance.
@@ -68,6 +74,14 @@ func (p *EonProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				MarkdownDescription: "Eon project ID. Can also be set with the `EON_PROJECT_ID` environment variable.",
 				Optional:            true,
 			},
+			"poll_interval": schema.StringAttribute{
+				MarkdownDescription: "How long to wait before first checking on a long-running job, such as a restore, as a duration like `30s` or `1m`. The wait grows after each check, up to `max_poll_interval`. Defaults to `10s`.",
+				Optional:            true,
+			},
+			"max_poll_interval": schema.StringAttribute{
+				MarkdownDescription: "Longest wait between checks on a long-running job, as a duration like `5m`. Defaults to `2m`.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -135,6 +149,8 @@ func (p *EonProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 	}
 
+	pollConfig := pollConfigFromProviderModel(&data, &resp.Diagnostics)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -150,16 +166,64 @@ func (p *EonProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		)
 		return
 	}
+	eonClient.PollConfig = pollConfig
 
 	resp.DataSourceData = eonClient
 	resp.ResourceData = eonClient
 }
 
+// pollConfigFromProviderModel applies the configured poll intervals to the default poll configuration
+func pollConfigFromProviderModel(data *EonProviderModel, diags *diag.Diagnostics) client.PollConfig {
+	pollConfig := client.DefaultPollConfig
+
+	if !data.PollInterval.IsNull() {
+		interval, err := time.ParseDuration(data.PollInterval.ValueString())
+		if err != nil || interval <= 0 {
+			diags.AddAttributeError(
+				path.Root("poll_interval"),
+				"Invalid Poll Interval",
+				fmt.Sprintf("poll_interval must be a positive duration such as `30s`, got %q.", data.PollInterval.ValueString()),
+			)
+		} else {
+			pollConfig.Interval = interval
+		}
+	}
+
+	if !data.MaxPollInterval.IsNull() {
+		maxInterval, err := time.ParseDuration(data.MaxPollInterval.ValueString())
+		if err != nil || maxInterval <= 0 {
+			diags.AddAttributeError(
+				path.Root("max_poll_interval"),
+				"Invalid Poll Interval",
+				fmt.Sprintf("max_poll_interval must be a positive duration such as `5m`, got %q.", data.MaxPollInterval.ValueString()),
+			)
+		} else {
+			pollConfig.MaxInterval = maxInterval
+		}
+	}
+
+	if !diags.HasError() && pollConfig.MaxInterval < pollConfig.Interval {
+		diags.AddAttributeError(
+			path.Root("max_poll_interval"),
+			"Invalid Poll Interval",
+			fmt.Sprintf("max_poll_interval (%s) can't be shorter than poll_interval (%s).", pollConfig.MaxInterval, pollConfig.Interval),
+		)
+	}
+
+	return pollConfig
+}
+
 func (p *EonProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewSourceAccountResource,
 		NewRestoreAccountResource,
 		NewRestoreJobResource,
+		NewEbsVolumeRestoreResource,
+		NewEc2InstanceRestoreResource,
+		NewRdsInstanceRestoreResource,
+		NewS3BucketRestoreResource,
+		NewS3FilesRestoreResource,
+		NewRestorePlanResource,
 		NewBackupPolicyResource,
 	}
 }
@@ -170,5 +234,10 @@ func (p *EonProvider) DataSources(ctx context.Context) []func() datasource.DataS
 		NewRestoreAccountsDataSource,
 		NewSnapshotDataSource,
 		NewBackupPoliciesDataSource,
+		NewInventoryResourcesDataSource,
+		NewInventoryResourceDataSource,
+		NewBackupPolicyPreviewDataSource,
+		NewRestoreJobsDataSource,
+		NewBackupJobsDataSource,
 	}
 }
@@ -2,7 +2,11 @@ package provider
 
 import (
 	"testing"
+	"time"
 
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -189,6 +193,38 @@ func TestProvider_ConfigurationValidation(t *testing.T) {
 	}
 }
 
+// TestPollConfigFromProviderModel tests applying the configured poll intervals
+func TestPollConfigFromProviderModel(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		pollInterval    types.String
+		maxPollInterval types.String
+		expected        client.PollConfig
+		shouldError     bool
+	}{
+		{"defaults", types.StringNull(), types.StringNull(), client.DefaultPollConfig, false},
+		{"custom intervals", types.StringValue("30s"), types.StringValue("10m"), client.PollConfig{Interval: 30 * time.Second, MaxInterval: 10 * time.Minute, Multiplier: client.DefaultPollConfig.Multiplier}, false},
+		{"invalid duration", types.StringValue("often"), types.StringNull(), client.PollConfig{}, true},
+		{"zero interval", types.StringValue("0s"), types.StringNull(), client.PollConfig{}, true},
+		{"max below interval", types.StringValue("5m"), types.StringValue("1m"), client.PollConfig{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var diags diag.Diagnostics
+			pollConfig := pollConfigFromProviderModel(&EonProviderModel{PollInterval: tt.pollInterval, MaxPollInterval: tt.maxPollInterval}, &diags)
+			assert.Equal(t, tt.shouldError, diags.HasError())
+			if !tt.shouldError {
+				assert.Equal(t, tt.expected, pollConfig)
+			}
+		})
+	}
+}
+
 // TestProvider_Concurrent tests concurrent provider creation
 func TestProvider_Concurrent(t *testing.T) {
 	t.Parallel()
@@ -7,6 +7,7 @@ import (
 
 	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
 	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -30,13 +31,14 @@ type BackupPolicyResource struct {
 }
 
 type BackupPolicyResourceModel struct {
-	Id               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Enabled          types.Bool   `tfsdk:"enabled"`
-	ResourceSelector types.Object `tfsdk:"resource_selector"`
-	BackupPlan       types.Object `tfsdk:"backup_plan"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	UpdatedAt        types.String `tfsdk:"updated_at"`
+	Id               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Enabled          types.Bool     `tfsdk:"enabled"`
+	ResourceSelector types.Object   `tfsdk:"resource_selector"`
+	BackupPlan       types.Object   `tfsdk:"backup_plan"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	UpdatedAt        types.String   `tfsdk:"updated_at"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
 type ResourceSelectorModel struct {
@@ -663,6 +665,9 @@ func (r *BackupPolicyResource) Schema(ctx context.Context, req resource.SchemaRe
 				Computed:            true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -688,46 +693,18 @@ func (r *BackupPolicyResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	resourceSelectorAttrs := data.ResourceSelector.Attributes()
-	resourceSelectionMode := resourceSelectorAttrs["resource_selection_mode"].(types.String)
-
-	resourceSelector := externalEonSdkAPI.NewBackupPolicyResourceSelector(
-		externalEonSdkAPI.ResourceSelectorMode(resourceSelectionMode.ValueString()),
-	)
-
-	if expressionObj, exists := resourceSelectorAttrs["expression"]; exists && !expressionObj.IsNull() {
-		var resourceSelectorModel ResourceSelectorModel
-		diags := data.ResourceSelector.As(ctx, &resourceSelectorModel, basetypes.ObjectAsOptions{})
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
-		}
-		expression, err := createBackupPolicyExpression(ctx, &resourceSelectorModel)
-		if err != nil {
-			resp.Diagnostics.AddError("Invalid Conditional Expression", fmt.Sprintf("Failed to create conditional expression: %s", err))
-			return
-		}
-		resourceSelector.SetExpression(*expression)
-	}
-
-	if inclusionOverrideObj, exists := resourceSelectorAttrs["resource_inclusion_override"]; exists && !inclusionOverrideObj.IsNull() {
-		var inclusionOverride []string
-		diags := inclusionOverrideObj.(types.List).ElementsAs(ctx, &inclusionOverride, false)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
-		}
-		resourceSelector.SetResourceInclusionOverride(inclusionOverride)
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
 
-	if exclusionOverrideObj, exists := resourceSelectorAttrs["resource_exclusion_override"]; exists && !exclusionOverrideObj.IsNull() {
-		var exclusionOverride []string
-		diags := exclusionOverrideObj.(types.List).ElementsAs(ctx, &exclusionOverride, false)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
-		}
-		resourceSelector.SetResourceExclusionOverride(exclusionOverride)
+	resourceSelector, selectorDiags := createBackupPolicyResourceSelector(ctx, data.ResourceSelector)
+	resp.Diagnostics.Append(selectorDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	backupPlanAttrs := data.BackupPlan.Attributes()
@@ -904,6 +881,14 @@ func (r *BackupPolicyResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	readTimeout, timeoutDiags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
 	policy, err := r.client.GetBackupPolicy(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read backup policy: %s", err))
@@ -933,46 +918,18 @@ func (r *BackupPolicyResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	resourceSelectorAttrs := plan.ResourceSelector.Attributes()
-	resourceSelectionMode := resourceSelectorAttrs["resource_selection_mode"].(types.String)
-
-	resourceSelector := externalEonSdkAPI.NewBackupPolicyResourceSelector(
-		externalEonSdkAPI.ResourceSelectorMode(resourceSelectionMode.ValueString()),
-	)
-
-	if expressionObj, exists := resourceSelectorAttrs["expression"]; exists && !expressionObj.IsNull() {
-		var resourceSelectorModel ResourceSelectorModel
-		diags := plan.ResourceSelector.As(ctx, &resourceSelectorModel, basetypes.ObjectAsOptions{})
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
-		}
-		expression, err := createBackupPolicyExpression(ctx, &resourceSelectorModel)
-		if err != nil {
-			resp.Diagnostics.AddError("Invalid Conditional Expression", fmt.Sprintf("Failed to create conditional expression: %s", err))
-			return
-		}
-		resourceSelector.SetExpression(*expression)
-	}
-
-	if inclusionOverrideObj, exists := resourceSelectorAttrs["resource_inclusion_override"]; exists && !inclusionOverrideObj.IsNull() {
-		var inclusionOverride []string
-		diags := inclusionOverrideObj.(types.List).ElementsAs(ctx, &inclusionOverride, false)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
-		}
-		resourceSelector.SetResourceInclusionOverride(inclusionOverride)
+	updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
 
-	if exclusionOverrideObj, exists := resourceSelectorAttrs["resource_exclusion_override"]; exists && !exclusionOverrideObj.IsNull() {
-		var exclusionOverride []string
-		diags := exclusionOverrideObj.(types.List).ElementsAs(ctx, &exclusionOverride, false)
-		if diags.HasError() {
-			resp.Diagnostics.Append(diags...)
-			return
-		}
-		resourceSelector.SetResourceExclusionOverride(exclusionOverride)
+	resourceSelector, selectorDiags := createBackupPolicyResourceSelector(ctx, plan.ResourceSelector)
+	resp.Diagnostics.Append(selectorDiags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	backupPlanAttrs := plan.BackupPlan.Attributes()
@@ -1146,6 +1103,14 @@ func (r *BackupPolicyResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	err := r.client.DeleteBackupPolicy(ctx, data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete backup policy: %s", err))
@@ -1275,6 +1240,52 @@ func createHighFrequencyScheduleConfig(schedule *BackupScheduleModel) (*external
 	}
 }
 
+// createBackupPolicyResourceSelector converts the resource_selector attribute into an API resource selector
+func createBackupPolicyResourceSelector(ctx context.Context, selector types.Object) (*externalEonSdkAPI.BackupPolicyResourceSelector, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	resourceSelectorAttrs := selector.Attributes()
+	resourceSelectionMode := resourceSelectorAttrs["resource_selection_mode"].(types.String)
+
+	resourceSelector := externalEonSdkAPI.NewBackupPolicyResourceSelector(
+		externalEonSdkAPI.ResourceSelectorMode(resourceSelectionMode.ValueString()),
+	)
+
+	if expressionObj, exists := resourceSelectorAttrs["expression"]; exists && !expressionObj.IsNull() {
+		var resourceSelectorModel ResourceSelectorModel
+		diags.Append(selector.As(ctx, &resourceSelectorModel, basetypes.ObjectAsOptions{})...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		expression, err := createBackupPolicyExpression(ctx, &resourceSelectorModel)
+		if err != nil {
+			diags.AddError("Invalid Conditional Expression", fmt.Sprintf("Failed to create conditional expression: %s", err))
+			return nil, diags
+		}
+		resourceSelector.SetExpression(*expression)
+	}
+
+	if inclusionOverrideObj, exists := resourceSelectorAttrs["resource_inclusion_override"]; exists && !inclusionOverrideObj.IsNull() {
+		var inclusionOverride []string
+		diags.Append(inclusionOverrideObj.(types.List).ElementsAs(ctx, &inclusionOverride, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		resourceSelector.SetResourceInclusionOverride(inclusionOverride)
+	}
+
+	if exclusionOverrideObj, exists := resourceSelectorAttrs["resource_exclusion_override"]; exists && !exclusionOverrideObj.IsNull() {
+		var exclusionOverride []string
+		diags.Append(exclusionOverrideObj.(types.List).ElementsAs(ctx, &exclusionOverride, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		resourceSelector.SetResourceExclusionOverride(exclusionOverride)
+	}
+
+	return resourceSelector, diags
+}
+
 func createBackupPolicyExpression(ctx context.Context, data *ResourceSelectorModel) (*externalEonSdkAPI.BackupPolicyExpression, error) {
 	if data.Expression.IsNull() {
 		return nil, fmt.Errorf("expression is required for CONDITIONAL resource selection mode")
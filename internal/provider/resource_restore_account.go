@@ -2,16 +2,21 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
 	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -28,14 +33,17 @@ type RestoreAccountResource struct {
 }
 
 type RestoreAccountResourceModel struct {
-	Id                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	ProviderAccountId types.String `tfsdk:"provider_account_id"`
-	CloudProvider     types.String `tfsdk:"cloud_provider"`
-	Role              types.String `tfsdk:"role"`
-	Status            types.String `tfsdk:"status"`
-	CreatedAt         types.String `tfsdk:"created_at"`
-	UpdatedAt         types.String `tfsdk:"updated_at"`
+	Id                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	ProviderAccountId types.String   `tfsdk:"provider_account_id"`
+	CloudProvider     types.String   `tfsdk:"cloud_provider"`
+	Role              types.String   `tfsdk:"role"`
+	MetricsEnabled    types.Bool     `tfsdk:"metrics_enabled"`
+	MetricsRegion     types.String   `tfsdk:"metrics_region"`
+	Status            types.String   `tfsdk:"status"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	UpdatedAt         types.String   `tfsdk:"updated_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *RestoreAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,8 +60,9 @@ func (r *RestoreAccountResource) Schema(ctx context.Context, req resource.Schema
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Account display name in Eon.",
+				MarkdownDescription: "Account display name in Eon. The Eon API can't rename accounts, so changing this disconnects the account and connects it again as a new account. The account gets a new Eon ID, and resources that reference its ID are replaced.",
 				Required:            true,
+				PlanModifiers:       []planmodifier.String{accountRequiresReplace()},
 			},
 			"provider_account_id": schema.StringAttribute{
 				MarkdownDescription: "Cloud-provider-assigned account ID.",
@@ -66,9 +75,26 @@ func (r *RestoreAccountResource) Schema(ctx context.Context, req resource.Schema
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "ARN of the role Eon assumes to access the account in AWS. **Required when creating new accounts**. Optional for imported accounts that already have a role configured in Eon.",
+				MarkdownDescription: "ARN of the role Eon assumes to access the account in AWS. **Required when creating new accounts**. Optional for imported accounts that already have a role configured in Eon. The Eon API can't change an account's role, so changing this disconnects the account and connects it again as a new account. The account gets a new Eon ID, and resources that reference its ID are replaced.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					accountRequiresReplace(),
+				},
+			},
+			"metrics_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether Eon publishes the restore account's job metrics to CloudWatch. Changes are applied in place. If not set, Terraform keeps the setting that's configured in Eon.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"metrics_region": schema.StringAttribute{
+				MarkdownDescription: "AWS region of the CloudWatch destination that job metrics are published to. Requires `metrics_enabled`. Changes are applied in place.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("metrics_enabled"))},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "Connection status of the AWS account, Azure subscription, or GCP project. Only `CONNECTED` restore accounts can be restored to. Possible values: `CONNECTED`, `DISCONNECTED`, `INSUFFICIENT_PERMISSIONS`.",
@@ -86,6 +112,9 @@ func (r *RestoreAccountResource) Schema(ctx context.Context, req resource.Schema
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -111,6 +140,14 @@ func (r *RestoreAccountResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Validate role is provided for new account creation
 	if data.Role.IsNull() || data.Role.ValueString() == "" {
 		resp.Diagnostics.AddError(
@@ -165,6 +202,18 @@ func (r *RestoreAccountResource) Create(ctx context.Context, req resource.Create
 	data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
 	data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
 
+	// The account is connected, so state is saved even if its metrics can't be configured. The resource is then
+	// tainted and connected again by the next apply.
+	if err := r.updateMetrics(ctx, &data, &RestoreAccountResourceModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure restore account metrics: %s", err))
+		if data.MetricsEnabled.IsUnknown() {
+			data.MetricsEnabled = types.BoolNull()
+		}
+		if data.MetricsRegion.IsUnknown() {
+			data.MetricsRegion = types.StringNull()
+		}
+	}
+
 	tflog.Debug(ctx, "Restore account connected", map[string]interface{}{
 		"id":     data.Id.ValueString(),
 		"name":   data.Name.ValueString(),
@@ -182,39 +231,47 @@ func (r *RestoreAccountResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	accounts, err := r.client.ListRestoreAccounts(ctx)
+	readTimeout, timeoutDiags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	account, err := r.client.GetRestoreAccount(ctx, data.Id.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Restore account not found, removing from state", map[string]interface{}{
+			"id": data.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore accounts: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore account: %s", err))
 		return
 	}
 
-	// Find the account by provider account ID
-	var found bool
-	for _, account := range accounts {
-		if account.Id == data.Id.ValueString() {
-			found = true
-			data.Status = types.StringValue(string(account.Status))
-			data.ProviderAccountId = types.StringValue(account.GetProviderAccountId())
-			if account.RestoreAccountAttributes.HasCloudProvider() {
-				data.CloudProvider = types.StringValue(string(account.RestoreAccountAttributes.GetCloudProvider()))
-			}
-
-			if data.CreatedAt.IsNull() || data.CreatedAt.IsUnknown() {
-				data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
-			}
-			if data.UpdatedAt.IsNull() || data.UpdatedAt.IsUnknown() {
-				data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
-			}
-
-			break
-		}
+	data.Status = types.StringValue(string(account.Status))
+	data.ProviderAccountId = types.StringValue(account.GetProviderAccountId())
+	if account.RestoreAccountAttributes.HasCloudProvider() {
+		data.CloudProvider = types.StringValue(string(account.RestoreAccountAttributes.GetCloudProvider()))
 	}
-
-	if !found {
-		resp.State.RemoveResource(ctx)
+	if roleArn, ok := accountRoleArn(account.RestoreAccountAttributes); ok {
+		data.Role = types.StringValue(roleArn)
+	}
+	if err := r.readMetrics(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore account metrics: %s", err))
 		return
 	}
 
+	if data.CreatedAt.IsNull() || data.CreatedAt.IsUnknown() {
+		data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+	if data.UpdatedAt.IsNull() || data.UpdatedAt.IsUnknown() {
+		data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -226,12 +283,69 @@ func (r *RestoreAccountResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	// For now, most changes require replace due to API limitations
-	resp.Diagnostics.AddWarning("Update Not Supported", "Most restore account changes require replacement. Please update your configuration to force replacement if needed.")
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state RestoreAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Job metrics are the only settings Eon can update. Every other value Eon records requires replacement, so
+	// the rest of an update only changes timeouts or adopts a value that Eon didn't report for an imported account.
+	tflog.Debug(ctx, "Updating restore account", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	if err := r.updateMetrics(ctx, &data, &state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update restore account metrics: %s", err))
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// updateMetrics applies the planned job metrics settings of the account and records the settings Eon reports
+func (r *RestoreAccountResource) updateMetrics(ctx context.Context, data *RestoreAccountResourceModel, state *RestoreAccountResourceModel) error {
+	if accountMetricsChanged(data.MetricsEnabled, state.MetricsEnabled, data.MetricsRegion, state.MetricsRegion) {
+		tflog.Debug(ctx, "Updating restore account metrics", map[string]interface{}{
+			"id":      data.Id.ValueString(),
+			"enabled": data.MetricsEnabled.ValueBool(),
+			"region":  data.MetricsRegion.ValueString(),
+		})
+
+		var err error
+		if data.MetricsEnabled.ValueBool() {
+			err = r.client.EnableRestoreAccountMetrics(ctx, data.Id.ValueString(), data.MetricsRegion.ValueString())
+		} else {
+			err = r.client.DisableRestoreAccountMetrics(ctx, data.Id.ValueString())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.readMetrics(ctx, data)
+}
+
+// readMetrics records the job metrics settings Eon reports for the account
+func (r *RestoreAccountResource) readMetrics(ctx context.Context, data *RestoreAccountResourceModel) error {
+	config, err := r.client.GetRestoreAccountMetricsConfig(ctx, data.Id.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.MetricsEnabled = types.BoolValue(config.Enabled)
+	data.MetricsRegion = accountMetricsRegion(config.Destination)
+	return nil
+}
+
 func (r *RestoreAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data RestoreAccountResourceModel
 
@@ -240,6 +354,14 @@ func (r *RestoreAccountResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Disconnecting restore account", map[string]interface{}{
 		"id": data.Id.ValueString(),
 	})
@@ -258,42 +380,35 @@ func (r *RestoreAccountResource) Delete(ctx context.Context, req resource.Delete
 func (r *RestoreAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 
-	accounts, err := r.client.ListRestoreAccounts(ctx)
+	account, err := r.client.GetRestoreAccount(ctx, req.ID)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("Restore account with ID %s not found", req.ID),
+		)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore accounts during import: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore account during import: %s", err))
 		return
 	}
 
-	var found bool
 	var data RestoreAccountResourceModel
+	data.Id = types.StringValue(account.Id)
+	data.Status = types.StringValue(string(account.Status))
+	data.ProviderAccountId = types.StringValue(account.GetProviderAccountId())
 
-	for _, account := range accounts {
-		if account.Id == req.ID {
-			found = true
-			data.Id = types.StringValue(account.Id)
-
-			data.Status = types.StringValue(string(account.Status))
-			data.ProviderAccountId = types.StringValue(account.GetProviderAccountId())
-
-			if account.RestoreAccountAttributes.HasCloudProvider() {
-				data.CloudProvider = types.StringValue(string(account.RestoreAccountAttributes.GetCloudProvider()))
-			}
-
-			data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
-			data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
-
-			break
-		}
+	if account.RestoreAccountAttributes.HasCloudProvider() {
+		data.CloudProvider = types.StringValue(string(account.RestoreAccountAttributes.GetCloudProvider()))
 	}
-
-	if !found {
-		resp.Diagnostics.AddError(
-			"Resource Not Found",
-			fmt.Sprintf("Restore account with ID %s not found", req.ID),
-		)
-		return
+	if roleArn, ok := accountRoleArn(account.RestoreAccountAttributes); ok {
+		data.Role = types.StringValue(roleArn)
 	}
 
+	data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+	data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	tflog.Info(ctx, "Successfully imported restore account", map[string]interface{}{
@@ -2,16 +2,21 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
 	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -28,14 +33,17 @@ type SourceAccountResource struct {
 }
 
 type SourceAccountResourceModel struct {
-	Id                types.String `tfsdk:"id"`
-	Name              types.String `tfsdk:"name"`
-	ProviderAccountId types.String `tfsdk:"provider_account_id"`
-	CloudProvider     types.String `tfsdk:"cloud_provider"`
-	Role              types.String `tfsdk:"role"`
-	Status            types.String `tfsdk:"status"`
-	CreatedAt         types.String `tfsdk:"created_at"`
-	UpdatedAt         types.String `tfsdk:"updated_at"`
+	Id                types.String   `tfsdk:"id"`
+	Name              types.String   `tfsdk:"name"`
+	ProviderAccountId types.String   `tfsdk:"provider_account_id"`
+	CloudProvider     types.String   `tfsdk:"cloud_provider"`
+	Role              types.String   `tfsdk:"role"`
+	MetricsEnabled    types.Bool     `tfsdk:"metrics_enabled"`
+	MetricsRegion     types.String   `tfsdk:"metrics_region"`
+	Status            types.String   `tfsdk:"status"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	UpdatedAt         types.String   `tfsdk:"updated_at"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *SourceAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,8 +60,9 @@ func (r *SourceAccountResource) Schema(ctx context.Context, req resource.SchemaR
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "Account display name in Eon.",
+				MarkdownDescription: "Account display name in Eon. The Eon API can't rename accounts, so changing this disconnects the account and connects it again as a new account. The account gets a new Eon ID, resources that reference its ID are replaced, and Eon doesn't back up the account in between.",
 				Required:            true,
+				PlanModifiers:       []planmodifier.String{accountRequiresReplace()},
 			},
 			"provider_account_id": schema.StringAttribute{
 				MarkdownDescription: "Cloud-provider-assigned account ID.",
@@ -66,9 +75,26 @@ func (r *SourceAccountResource) Schema(ctx context.Context, req resource.SchemaR
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"role": schema.StringAttribute{
-				MarkdownDescription: "ARN of the role Eon assumes to access the account in AWS. **Required when creating new accounts**. Optional for imported accounts that already have a role configured in Eon.",
+				MarkdownDescription: "ARN of the role Eon assumes to access the account in AWS. **Required when creating new accounts**. Optional for imported accounts that already have a role configured in Eon. The Eon API can't change an account's role, so changing this disconnects the account and connects it again as a new account. The account gets a new Eon ID, resources that reference its ID are replaced, and Eon doesn't back up the account in between.",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					accountRequiresReplace(),
+				},
+			},
+			"metrics_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether Eon publishes the source account's job metrics to CloudWatch. Changes are applied in place. If not set, Terraform keeps the setting that's configured in Eon.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"metrics_region": schema.StringAttribute{
+				MarkdownDescription: "AWS region of the CloudWatch destination that job metrics are published to. Requires `metrics_enabled`. Changes are applied in place.",
+				Optional:            true,
+				Computed:            true,
+				Validators:          []validator.String{stringvalidator.AlsoRequires(path.MatchRoot("metrics_enabled"))},
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 			"status": schema.StringAttribute{
 				MarkdownDescription: "Connection status of the AWS account, Azure subscription, or GCP project. Only `CONNECTED` source accounts can be backed up. Possible values: `CONNECTED`, `DISCONNECTED`, `INSUFFICIENT_PERMISSIONS`.",
@@ -86,7 +112,52 @@ func (r *SourceAccountResource) Schema(ctx context.Context, req resource.SchemaR
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// accountRequiresReplace replaces an account connection when an argument Eon can't update changes. Values that
+// Eon didn't report, such as the role of an account imported before roles were read, are adopted in place instead.
+func accountRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"The Eon API can't update this value, so changing it will destroy and recreate the account connection.",
+		"The Eon API can't update this value, so changing it will destroy and recreate the account connection.",
+	)
+}
+
+// accountRoleArn returns the AWS role ARN of an account, if Eon reports one
+func accountRoleArn(config *externalEonSdkAPI.AccountConfig) (string, bool) {
+	if config == nil {
+		return "", false
+	}
+	awsConfig := config.Aws.Get()
+	if awsConfig == nil || awsConfig.RoleArn == "" {
+		return "", false
 	}
+	return awsConfig.RoleArn, true
+}
+
+// accountMetricsRegion returns the CloudWatch region of an account's metrics destination, if Eon reports one
+func accountMetricsRegion(destination externalEonSdkAPI.AccountMetricsDestination) types.String {
+	awsDestination := destination.Aws.Get()
+	if awsDestination == nil || awsDestination.Region == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(*awsDestination.Region)
+}
+
+// accountMetricsChanged reports whether the planned job metrics settings differ from those in state. Settings that
+// aren't configured are unknown when an account is connected, and are left as Eon has them.
+func accountMetricsChanged(enabled, stateEnabled types.Bool, region, stateRegion types.String) bool {
+	if enabled.IsUnknown() {
+		return false
+	}
+	return !enabled.Equal(stateEnabled) || (!region.IsUnknown() && !region.Equal(stateRegion))
 }
 
 func (r *SourceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -111,6 +182,14 @@ func (r *SourceAccountResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
 	// Validate role is provided for new account creation
 	if data.Role.IsNull() || data.Role.ValueString() == "" {
 		resp.Diagnostics.AddError(
@@ -165,6 +244,18 @@ func (r *SourceAccountResource) Create(ctx context.Context, req resource.CreateR
 	data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
 	data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
 
+	// The account is connected, so state is saved even if its metrics can't be configured. The resource is then
+	// tainted and connected again by the next apply.
+	if err := r.updateMetrics(ctx, &data, &SourceAccountResourceModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure source account metrics: %s", err))
+		if data.MetricsEnabled.IsUnknown() {
+			data.MetricsEnabled = types.BoolNull()
+		}
+		if data.MetricsRegion.IsUnknown() {
+			data.MetricsRegion = types.StringNull()
+		}
+	}
+
 	tflog.Debug(ctx, "Source account connected", map[string]interface{}{
 		"id":     data.Id.ValueString(),
 		"name":   data.Name.ValueString(),
@@ -182,40 +273,49 @@ func (r *SourceAccountResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	accounts, err := r.client.ListSourceAccounts(ctx)
+	readTimeout, timeoutDiags := data.Timeouts.Read(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	account, err := r.client.GetSourceAccount(ctx, data.Id.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		tflog.Warn(ctx, "Source account not found, removing from state", map[string]interface{}{
+			"id": data.Id.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read source accounts: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read source account: %s", err))
 		return
 	}
 
-	var found bool
-	for _, account := range accounts {
-		if account.Id == data.Id.ValueString() {
-			found = true
-			data.Name = types.StringValue(account.GetName())
-			data.Status = types.StringValue(string(account.Status))
-			data.ProviderAccountId = types.StringValue(account.GetProviderAccountId())
-
-			if account.SourceAccountAttributes.HasCloudProvider() {
-				data.CloudProvider = types.StringValue(string(account.SourceAccountAttributes.GetCloudProvider()))
-			}
-
-			if data.CreatedAt.IsNull() || data.CreatedAt.IsUnknown() {
-				data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
-			}
-			if data.UpdatedAt.IsNull() || data.UpdatedAt.IsUnknown() {
-				data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
-			}
+	data.Name = types.StringValue(account.GetName())
+	data.Status = types.StringValue(string(account.Status))
+	data.ProviderAccountId = types.StringValue(account.GetProviderAccountId())
 
-			break
-		}
+	if account.SourceAccountAttributes.HasCloudProvider() {
+		data.CloudProvider = types.StringValue(string(account.SourceAccountAttributes.GetCloudProvider()))
 	}
-
-	if !found {
-		resp.State.RemoveResource(ctx)
+	if roleArn, ok := accountRoleArn(account.SourceAccountAttributes); ok {
+		data.Role = types.StringValue(roleArn)
+	}
+	if err := r.readMetrics(ctx, &data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read source account metrics: %s", err))
 		return
 	}
 
+	if data.CreatedAt.IsNull() || data.CreatedAt.IsUnknown() {
+		data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+	if data.UpdatedAt.IsNull() || data.UpdatedAt.IsUnknown() {
+		data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -227,11 +327,69 @@ func (r *SourceAccountResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	resp.Diagnostics.AddWarning("Update Not Supported", "Most source account changes require replacement. Please update your configuration to force replacement if needed.")
+	updateTimeout, timeoutDiags := data.Timeouts.Update(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var state SourceAccountResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Job metrics are the only settings Eon can update. Every other value Eon records requires replacement, so
+	// the rest of an update only changes timeouts or adopts a value that Eon didn't report for an imported account.
+	tflog.Debug(ctx, "Updating source account", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	if err := r.updateMetrics(ctx, &data, &state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update source account metrics: %s", err))
+		return
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// updateMetrics applies the planned job metrics settings of the account and records the settings Eon reports
+func (r *SourceAccountResource) updateMetrics(ctx context.Context, data *SourceAccountResourceModel, state *SourceAccountResourceModel) error {
+	if accountMetricsChanged(data.MetricsEnabled, state.MetricsEnabled, data.MetricsRegion, state.MetricsRegion) {
+		tflog.Debug(ctx, "Updating source account metrics", map[string]interface{}{
+			"id":      data.Id.ValueString(),
+			"enabled": data.MetricsEnabled.ValueBool(),
+			"region":  data.MetricsRegion.ValueString(),
+		})
+
+		var err error
+		if data.MetricsEnabled.ValueBool() {
+			err = r.client.EnableSourceAccountMetrics(ctx, data.Id.ValueString(), data.MetricsRegion.ValueString())
+		} else {
+			err = r.client.DisableSourceAccountMetrics(ctx, data.Id.ValueString())
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return r.readMetrics(ctx, data)
+}
+
+// readMetrics records the job metrics settings Eon reports for the account
+func (r *SourceAccountResource) readMetrics(ctx context.Context, data *SourceAccountResourceModel) error {
+	config, err := r.client.GetSourceAccountMetricsConfig(ctx, data.Id.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.MetricsEnabled = types.BoolValue(config.Enabled)
+	data.MetricsRegion = accountMetricsRegion(config.Destination)
+	return nil
+}
+
 func (r *SourceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data SourceAccountResourceModel
 
@@ -240,6 +398,14 @@ func (r *SourceAccountResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
 	tflog.Debug(ctx, "Disconnecting source account", map[string]interface{}{
 		"id": data.Id.ValueString(),
 	})
@@ -258,43 +424,37 @@ func (r *SourceAccountResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *SourceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
 
-	accounts, err := r.client.ListSourceAccounts(ctx)
+	account, err := r.client.GetSourceAccount(ctx, req.ID)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("Source account with ID %s not found", req.ID),
+		)
+		return
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read source accounts during import: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read source account during import: %s", err))
 		return
 	}
 
-	var found bool
 	var data SourceAccountResourceModel
 
-	for _, account := range accounts {
-		if account.Id == req.ID {
-			found = true
-
-			data.Id = types.StringValue(account.Id)
-			data.Name = types.StringValue(account.GetName())
-			data.Status = types.StringValue(string(account.Status))
-			data.ProviderAccountId = types.StringValue(account.GetProviderAccountId())
-
-			if account.SourceAccountAttributes.HasCloudProvider() {
-				data.CloudProvider = types.StringValue(string(account.SourceAccountAttributes.GetCloudProvider()))
-			}
-
-			data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
-			data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+	data.Id = types.StringValue(account.Id)
+	data.Name = types.StringValue(account.GetName())
+	data.Status = types.StringValue(string(account.Status))
+	data.ProviderAccountId = types.StringValue(account.GetProviderAccountId())
 
-			break
-		}
+	if account.SourceAccountAttributes.HasCloudProvider() {
+		data.CloudProvider = types.StringValue(string(account.SourceAccountAttributes.GetCloudProvider()))
 	}
-
-	if !found {
-		resp.Diagnostics.AddError(
-			"Resource Not Found",
-			fmt.Sprintf("Source account with ID %s not found", req.ID),
-		)
-		return
+	if roleArn, ok := accountRoleArn(account.SourceAccountAttributes); ok {
+		data.Role = types.StringValue(roleArn)
 	}
 
+	data.CreatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+	data.UpdatedAt = types.StringValue(time.Now().Format(time.RFC3339))
+
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("timeouts"), &data.Timeouts)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 
 	tflog.Info(ctx, "Successfully imported source account", map[string]interface{}{
@@ -0,0 +1,244 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAccountSchemas tests that account arguments Eon can't update require replacement
+func TestAccountSchemas(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	for _, r := range []resource.Resource{NewSourceAccountResource(), NewRestoreAccountResource()} {
+		resp := &resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, resp)
+		require.False(t, resp.Diagnostics.HasError())
+		assert.False(t, resp.Schema.ValidateImplementation(ctx).HasError())
+
+		for _, name := range []string{"name", "role", "provider_account_id", "cloud_provider"} {
+			assert.True(t, attributeRequiresReplace(ctx, resp.Schema.Attributes[name]), "attribute %s should require replacement", name)
+		}
+		for _, name := range []string{"metrics_enabled", "metrics_region"} {
+			assert.False(t, attributeRequiresReplace(ctx, resp.Schema.Attributes[name]), "attribute %s should be updated in place", name)
+		}
+	}
+}
+
+// TestAccountRequiresReplace tests that only changes to values recorded in state replace an account connection
+func TestAccountRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := &resource.SchemaResponse{}
+	NewSourceAccountResource().Schema(ctx, resource.SchemaRequest{}, resourceSchema)
+	objectType := resourceSchema.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+	state := tfsdk.State{
+		Schema: resourceSchema.Schema,
+		Raw:    tftypes.NewValue(objectType, attributes),
+	}
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+
+	tests := []struct {
+		name            string
+		stateValue      types.String
+		planValue       types.String
+		requiresReplace bool
+	}{
+		{"changed", types.StringValue("old-name"), types.StringValue("new-name"), true},
+		{"unchanged", types.StringValue("name"), types.StringValue("name"), false},
+		{"not reported by eon", types.StringNull(), types.StringValue("name"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &planmodifier.StringResponse{PlanValue: tt.planValue}
+			accountRequiresReplace().PlanModifyString(ctx, planmodifier.StringRequest{
+				State:       state,
+				Plan:        plan,
+				StateValue:  tt.stateValue,
+				PlanValue:   tt.planValue,
+				ConfigValue: tt.planValue,
+			}, resp)
+			assert.Equal(t, tt.requiresReplace, resp.RequiresReplace)
+		})
+	}
+}
+
+// TestAccountRoleArn tests reading the AWS role ARN of an account
+func TestAccountRoleArn(t *testing.T) {
+	t.Parallel()
+
+	_, ok := accountRoleArn(nil)
+	assert.False(t, ok)
+
+	config := externalEonSdkAPI.NewAccountConfig()
+	_, ok = accountRoleArn(config)
+	assert.False(t, ok)
+
+	config.SetAws(*externalEonSdkAPI.NewAwsAccountConfig("arn:aws:iam::123456789012:role/EonRole"))
+	roleArn, ok := accountRoleArn(config)
+	require.True(t, ok)
+	assert.Equal(t, "arn:aws:iam::123456789012:role/EonRole", roleArn)
+}
+
+// TestAccountMetricsChanged tests deciding whether planned job metrics settings need to be applied
+func TestAccountMetricsChanged(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		enabled      types.Bool
+		stateEnabled types.Bool
+		region       types.String
+		stateRegion  types.String
+		changed      bool
+	}{
+		{"not configured", types.BoolUnknown(), types.BoolNull(), types.StringUnknown(), types.StringNull(), false},
+		{"enabled on connect", types.BoolValue(true), types.BoolNull(), types.StringUnknown(), types.StringNull(), true},
+		{"unchanged", types.BoolValue(true), types.BoolValue(true), types.StringValue("us-east-1"), types.StringValue("us-east-1"), false},
+		{"disabled", types.BoolValue(false), types.BoolValue(true), types.StringValue("us-east-1"), types.StringValue("us-east-1"), true},
+		{"region changed", types.BoolValue(true), types.BoolValue(true), types.StringValue("eu-west-1"), types.StringValue("us-east-1"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.changed, accountMetricsChanged(tt.enabled, tt.stateEnabled, tt.region, tt.stateRegion))
+		})
+	}
+}
+
+// fakeAccountMetrics is a fake Eon API that records the job metrics settings of a single account
+type fakeAccountMetrics struct {
+	mu      sync.Mutex
+	enabled bool
+	region  *string
+	updates int
+}
+
+// newAccountMetricsTestClient returns a client for a fake Eon API that serves the metrics settings of source and
+// restore account "account-1"
+func newAccountMetricsTestClient(t *testing.T, metrics *fakeAccountMetrics) *client.EonClient {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/token") {
+			_, _ = w.Write([]byte(`{"accessToken": "token", "expirationSeconds": 3600}`))
+			return
+		}
+
+		metrics.mu.Lock()
+		defer metrics.mu.Unlock()
+
+		switch r.Method {
+		case http.MethodPut:
+			var req externalEonSdkAPI.EnableSourceAccountMetricsConfigRequest
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				http.Error(w, `{"error": "invalid request"}`, http.StatusBadRequest)
+				return
+			}
+			metrics.enabled = true
+			metrics.region = nil
+			if destination := req.Aws.Get(); destination != nil {
+				metrics.region = destination.Region
+			}
+			metrics.updates++
+		case http.MethodDelete:
+			metrics.enabled = false
+			metrics.updates++
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		config := map[string]interface{}{
+			"sourceAccountId":  "account-1",
+			"restoreAccountId": "account-1",
+			"enabled":          metrics.enabled,
+			"destination":      map[string]interface{}{},
+		}
+		if metrics.region != nil {
+			config["destination"] = map[string]interface{}{"aws": map[string]interface{}{"region": *metrics.region}}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"sourceAccountConfig": config, "restoreAccountConfig": config})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewEonClient(server.URL, "client-id", "client-secret", "project-id")
+	require.NoError(t, err)
+	return c
+}
+
+// TestUpdateAccountMetrics tests applying job metrics settings to source and restore accounts
+func TestUpdateAccountMetrics(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("source account", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := &fakeAccountMetrics{}
+		r := &SourceAccountResource{client: newAccountMetricsTestClient(t, metrics)}
+
+		data := &SourceAccountResourceModel{Id: types.StringValue("account-1"), MetricsEnabled: types.BoolUnknown(), MetricsRegion: types.StringUnknown()}
+		require.NoError(t, r.updateMetrics(ctx, data, &SourceAccountResourceModel{}))
+		assert.Equal(t, 0, metrics.updates)
+		assert.False(t, data.MetricsEnabled.ValueBool())
+		assert.True(t, data.MetricsRegion.IsNull())
+
+		state := *data
+		data.MetricsEnabled = types.BoolValue(true)
+		data.MetricsRegion = types.StringValue("us-east-1")
+		require.NoError(t, r.updateMetrics(ctx, data, &state))
+		assert.Equal(t, 1, metrics.updates)
+		assert.True(t, data.MetricsEnabled.ValueBool())
+		assert.Equal(t, "us-east-1", data.MetricsRegion.ValueString())
+
+		state = *data
+		data.MetricsEnabled = types.BoolValue(false)
+		require.NoError(t, r.updateMetrics(ctx, data, &state))
+		assert.Equal(t, 2, metrics.updates)
+		assert.False(t, data.MetricsEnabled.ValueBool())
+	})
+
+	t.Run("restore account", func(t *testing.T) {
+		t.Parallel()
+
+		metrics := &fakeAccountMetrics{}
+		r := &RestoreAccountResource{client: newAccountMetricsTestClient(t, metrics)}
+
+		data := &RestoreAccountResourceModel{Id: types.StringValue("account-1"), MetricsEnabled: types.BoolValue(true), MetricsRegion: types.StringValue("eu-west-1")}
+		require.NoError(t, r.updateMetrics(ctx, data, &RestoreAccountResourceModel{}))
+		assert.Equal(t, 1, metrics.updates)
+		assert.True(t, data.MetricsEnabled.ValueBool())
+		assert.Equal(t, "eu-west-1", data.MetricsRegion.ValueString())
+
+		state := *data
+		require.NoError(t, r.updateMetrics(ctx, data, &state))
+		assert.Equal(t, 1, metrics.updates)
+	})
+}
@@ -1,26 +1,50 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
 	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &RestoreJobResource{}
 var _ resource.ResourceWithImportState = &RestoreJobResource{}
+var _ resource.ResourceWithModifyPlan = &RestoreJobResource{}
+var _ resource.ResourceWithUpgradeState = &RestoreJobResource{}
+var _ resource.ResourceWithConfigValidators = &RestoreJobResource{}
 
 func NewRestoreJobResource() resource.Resource {
 	return &RestoreJobResource{}
@@ -35,7 +59,9 @@ type RestoreJobResourceModel struct {
 	RestoreType      types.String `tfsdk:"restore_type"`
 	SnapshotId       types.String `tfsdk:"snapshot_id"`
 	ResourceId       types.String `tfsdk:"resource_id"`
+	PointInTime      types.String `tfsdk:"point_in_time"`
 	RestoreAccountId types.String `tfsdk:"restore_account_id"`
+	Triggers         types.Map    `tfsdk:"triggers"`
 
 	// Restore type specific configuration blocks
 	EbsConfig      *EbsRestoreConfig      `tfsdk:"ebs_config"`
@@ -43,10 +69,15 @@ type RestoreJobResourceModel struct {
 	RdsConfig      *RdsRestoreConfig      `tfsdk:"rds_config"`
 	S3BucketConfig *S3BucketRestoreConfig `tfsdk:"s3_bucket_config"`
 	S3FileConfig   *S3FileRestoreConfig   `tfsdk:"s3_file_config"`
+	DynamoDbConfig *DynamoDbRestoreConfig `tfsdk:"dynamodb_config"`
+
+	AzureBlobConfig     *AzureBlobRestoreConfig     `tfsdk:"azure_blob_config"`
+	AzureBlobFileConfig *AzureBlobFileRestoreConfig `tfsdk:"azure_blob_file_config"`
 
 	// Common fields
-	TimeoutMinutes    types.Int64 `tfsdk:"timeout_minutes"`
-	WaitForCompletion types.Bool  `tfsdk:"wait_for_completion"`
+	TimeoutMinutes    types.Int64    `tfsdk:"timeout_minutes"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
 
 	// Job status fields (computed)
 	JobId           types.String `tfsdk:"job_id"`
@@ -56,13 +87,38 @@ type RestoreJobResourceModel struct {
 	StartedAt       types.String `tfsdk:"started_at"`
 	CompletedAt     types.String `tfsdk:"completed_at"`
 	DurationSeconds types.Int64  `tfsdk:"duration_seconds"`
+
+	// Snapshot resolution fields (computed)
+	RecoveryPointTime types.String `tfsdk:"recovery_point_time"`
+
+	// Restored resource fields (computed)
+	RestoredResources  types.List   `tfsdk:"restored_resources"`
+	RestoredVolumeId   types.String `tfsdk:"restored_volume_id"`
+	RestoredInstanceId types.String `tfsdk:"restored_instance_id"`
+	RestoredDiskId     types.String `tfsdk:"restored_disk_id"`
+}
+
+type RestoredResourceModel struct {
+	ResourceType       types.String `tfsdk:"resource_type"`
+	ProviderResourceId types.String `tfsdk:"provider_resource_id"`
+	CloudProvider      types.String `tfsdk:"cloud_provider"`
+	ProviderAccountId  types.String `tfsdk:"provider_account_id"`
+	Region             types.String `tfsdk:"region"`
+}
+
+var restoredResourceAttrTypes = map[string]attr.Type{
+	"resource_type":        types.StringType,
+	"provider_resource_id": types.StringType,
+	"cloud_provider":       types.StringType,
+	"provider_account_id":  types.StringType,
+	"region":               types.StringType,
 }
 
 type EbsRestoreConfig struct {
 	ProviderVolumeId           types.String `tfsdk:"provider_volume_id"`
 	AvailabilityZone           types.String `tfsdk:"availability_zone"`
 	VolumeType                 types.String `tfsdk:"volume_type"`
-	VolumeSize                 types.Int64  `tfsdk:"volume_size"` // Size in bytes
+	VolumeSize                 SizeValue    `tfsdk:"volume_size"`
 	Iops                       types.Int64  `tfsdk:"iops"`
 	Throughput                 types.Int64  `tfsdk:"throughput"`
 	Description                types.String `tfsdk:"description"`
@@ -87,7 +143,7 @@ type RdsRestoreConfig struct {
 	Region                types.String `tfsdk:"region"`
 	SubnetGroupName       types.String `tfsdk:"subnet_group_name"`
 	VpcSecurityGroupIds   types.List   `tfsdk:"vpc_security_group_ids"`
-	AllocatedStorage      types.Int64  `tfsdk:"allocated_storage"`
+	AllocatedStorage      SizeValue    `tfsdk:"allocated_storage"`
 	StorageType           types.String `tfsdk:"storage_type"`
 	Tags                  types.Map    `tfsdk:"tags"`
 	BackupRetentionPeriod types.Int64  `tfsdk:"backup_retention_period"`
@@ -110,10 +166,33 @@ type S3FileRestoreConfig struct {
 	Files      types.List   `tfsdk:"files"`
 }
 
+type DynamoDbRestoreConfig struct {
+	TableName          types.String `tfsdk:"table_name"`
+	Region             types.String `tfsdk:"region"`
+	KmsKeyId           types.String `tfsdk:"kms_key_id"`
+	WriteCapacityUnits types.Int64  `tfsdk:"write_capacity_units"`
+	Tags               types.Map    `tfsdk:"tags"`
+}
+
+type AzureBlobRestoreConfig struct {
+	StorageAccountName types.String `tfsdk:"storage_account_name"`
+	ResourceGroup      types.String `tfsdk:"resource_group"`
+	Container          types.String `tfsdk:"container"`
+	Prefix             types.String `tfsdk:"prefix"`
+}
+
+type AzureBlobFileRestoreConfig struct {
+	StorageAccountName types.String `tfsdk:"storage_account_name"`
+	ResourceGroup      types.String `tfsdk:"resource_group"`
+	Container          types.String `tfsdk:"container"`
+	Prefix             types.String `tfsdk:"prefix"`
+	Files              types.List   `tfsdk:"files"`
+}
+
 type VolumeRestoreParam struct {
 	ProviderVolumeId types.String `tfsdk:"provider_volume_id"`
 	VolumeType       types.String `tfsdk:"volume_type"`
-	VolumeSize       types.Int64  `tfsdk:"volume_size"` // Size in bytes
+	VolumeSize       SizeValue    `tfsdk:"volume_size"`
 	Iops             types.Int64  `tfsdk:"iops"`
 	Throughput       types.Int64  `tfsdk:"throughput"`
 	Description      types.String `tfsdk:"description"`
@@ -131,7 +210,9 @@ func (r *RestoreJobResource) Metadata(ctx context.Context, req resource.Metadata
 
 func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Triggers a restore job to restore data from an Eon snapshot. This operation is asynchronous and returns a job ID that can be used to track the progress of the restore job.",
+		// Version 1 changed volume_size and allocated_storage from byte counts to sizes with units
+		Version:             1,
+		MarkdownDescription: "Triggers a restore job to restore data from an Eon snapshot. This operation is asynchronous and returns a job ID that can be used to track the progress of the restore job.\n\nChanging any restore input or `triggers` starts a new restore job. Only `wait_for_completion`, `timeout_minutes`, and `timeouts` can change in place.\n\nDestroying this resource only removes it from Terraform state. It doesn't cancel a running job or delete restored resources, because the Eon API doesn't support cancelling restore jobs. If the job is still running when it's destroyed, the provider shows a warning so you can cancel it from the Eon console.\n\nThe `eon_ebs_volume_restore`, `eon_ec2_instance_restore`, `eon_rds_instance_restore`, `eon_s3_bucket_restore`, and `eon_s3_files_restore` resources restore one type of resource each, with the configuration block's attributes at the top level. Existing restore jobs can move to them with `moved` blocks.\n\nRestore jobs started outside Terraform, for example from the Eon console, can be imported by job ID. Import reads the snapshot, resource, restore account, restore type, configuration block and target region from Eon. The Eon API doesn't report the other target settings, so add them to the configuration. The first apply after import records them in state without starting a new restore job.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -141,32 +222,52 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 			"restore_type": schema.StringAttribute{
 				MarkdownDescription: "Type of restore job: `full` for full resource restore, `partial` for partial restore.",
 				Required:            true,
+				Validators:          []validator.String{stringvalidator.OneOf("full", "partial")},
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
 			"snapshot_id": schema.StringAttribute{
-				MarkdownDescription: "ID of the Eon snapshot to restore from.",
-				Required:            true,
-				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				MarkdownDescription: "ID of the Eon snapshot to restore from. Either `snapshot_id` or `point_in_time` must be set. When `point_in_time` is set, this is the ID of the snapshot it resolved to.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"point_in_time": schema.StringAttribute{
+				MarkdownDescription: "Date and time to restore the resource to, in RFC 3339 format. Restores from the latest snapshot of `resource_id` taken at or before this time. Requires `resource_id` and can't be used with `snapshot_id`.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{restoreInputRequiresReplace()},
 			},
 			"resource_id": schema.StringAttribute{
-				MarkdownDescription: "Eon-assigned ID of the resource to restore from (defaults to snapshot_id if not provided).",
+				MarkdownDescription: "Eon-assigned ID of the resource to restore from. Required with `point_in_time`; otherwise read from the snapshot during plan. When set with `snapshot_id`, it must be the snapshot's resource.",
 				Optional:            true,
 				Computed:            true,
-				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"restore_account_id": schema.StringAttribute{
 				MarkdownDescription: "Eon-assigned ID of the restore account.",
 				Required:            true,
 				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
 			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary key-value pairs that start a new restore job when any of them change, for example a timestamp to rerun a scheduled disaster recovery drill.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Map{restoreMapInputRequiresReplace()},
+			},
 			"timeout_minutes": schema.Int64Attribute{
-				MarkdownDescription: "Timeout in minutes for restore operation.",
+				MarkdownDescription: "Timeout in minutes for restore operation. Deprecated: use `timeouts.create` and `timeouts.read` instead, which take precedence over this value when set.",
+				DeprecationMessage:  "Use the timeouts block instead. For example, replace timeout_minutes = 120 with timeouts { create = \"120m\" }.",
 				Optional:            true,
 				Computed:            true,
 				Default:             int64default.StaticInt64(60),
 			},
 			"wait_for_completion": schema.BoolAttribute{
-				MarkdownDescription: "Whether to wait for completion.",
+				MarkdownDescription: "Whether to wait for completion. If the job is still running when the create timeout expires, its current status is stored and the next plan or apply resumes waiting for it. If the job fails, the apply fails and the next apply starts a new restore job.",
 				Optional:            true,
 				Computed:            true,
 				Default:             booldefault.StaticBool(true),
@@ -176,7 +277,7 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Computed:            true,
 			},
 			"status": schema.StringAttribute{
-				MarkdownDescription: "Current status of the restore job. Possible values: `JOB_UNSPECIFIED`, `JOB_PENDING`, `JOB_RUNNING`, `JOB_COMPLETED`, `JOB_FAILED`, `JOB_PARTIAL`.",
+				MarkdownDescription: "Current status of the restore job. Possible values: `JOB_UNSPECIFIED`, `JOB_PENDING`, `JOB_RUNNING`, `JOB_COMPLETED`, `JOB_FAILED`, `JOB_PARTIAL`, `JOB_CANCELLED`.",
 				Computed:            true,
 			},
 			"status_message": schema.StringAttribute{
@@ -199,26 +300,61 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "How long the job took, in seconds.",
 				Computed:            true,
 			},
+			"recovery_point_time": schema.StringAttribute{
+				MarkdownDescription: "Date and time of the resource data preserved by the restored snapshot.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"restored_resources": schema.ListNestedAttribute{
+				MarkdownDescription: "Resources created by the restore job. Empty until the job reports its results. Eon reports results for EC2 instance, EBS volume, and Azure disk restores.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: restoredResourceAttributes(),
+				},
+			},
+			"restored_volume_id": schema.StringAttribute{
+				MarkdownDescription: "Cloud-provider-assigned ID of the restored EBS volume, if the job restored one.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"restored_instance_id": schema.StringAttribute{
+				MarkdownDescription: "Cloud-provider-assigned ID of the restored EC2 instance, if the job restored one.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"restored_disk_id": schema.StringAttribute{
+				MarkdownDescription: "Cloud-provider-assigned ID of the restored Azure disk, if the job restored one.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 			"ebs_config": schema.SingleNestedBlock{
 				MarkdownDescription: "EBS volume restore configuration. Required when restoring AWS EC2 volume with `partial` restore type.",
+				PlanModifiers:       []planmodifier.Object{restoreConfigRequiresReplace()},
 				Attributes: map[string]schema.Attribute{
 					"provider_volume_id": schema.StringAttribute{
 						MarkdownDescription: "Cloud-provider-assigned ID of the volume to restore.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"availability_zone": schema.StringAttribute{
 						MarkdownDescription: "Availability zone to restore the volume to.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"volume_type": schema.StringAttribute{
 						MarkdownDescription: "EBS volume type (gp2, gp3, io1, io2, etc.).",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
-					"volume_size": schema.Int64Attribute{
-						MarkdownDescription: "Volume size in bytes.",
-						Optional:            true,
+					"volume_size": schema.StringAttribute{
+						MarkdownDescription: "Size of the restored volume. Must be at least the size of the source volume. " + ebsVolumeSizeDescription,
+						CustomType:          SizeType{},
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1), sizeUnitRequired()},
 					},
 					"iops": schema.Int64Attribute{
 						MarkdownDescription: "IOPS for volume (required for io1/io2).",
@@ -251,18 +387,22 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"ec2_config": schema.SingleNestedBlock{
 				MarkdownDescription: "EC2 instance restore configuration. Required when restoring AWS EC2 instance with `full` restore type.",
+				PlanModifiers:       []planmodifier.Object{restoreConfigRequiresReplace()},
 				Attributes: map[string]schema.Attribute{
 					"region": schema.StringAttribute{
 						MarkdownDescription: "Region to restore the instance to.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"instance_type": schema.StringAttribute{
 						MarkdownDescription: "Instance type to use for the restored instance.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"subnet_id": schema.StringAttribute{
 						MarkdownDescription: "Subnet ID to associate with the restored instance.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"security_group_ids": schema.ListAttribute{
 						MarkdownDescription: "List of security group IDs to associate with the restored instance.",
@@ -277,20 +417,24 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 				Blocks: map[string]schema.Block{
 					"volume_restore_params": schema.ListNestedBlock{
-						MarkdownDescription: "Volumes to restore and attach to the restored instance. Each item corresponds to a volume to be restored, where `provider_volume_id` matches the volume's ID at the time of the snapshot. The root volume must be present in the list.",
+						MarkdownDescription: "Volumes to restore and attach to the restored instance. Each item corresponds to a volume to be restored, where `provider_volume_id` matches the volume's ID at the time of the snapshot. Only the listed volumes are restored, and the root volume must be present in the list. Each `provider_volume_id` must be a volume in the snapshot.",
+						Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"provider_volume_id": schema.StringAttribute{
 									MarkdownDescription: "Cloud-provider-assigned ID of the volume to restore.",
-									Optional:            true,
+									Required:            true,
+									Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 								},
 								"volume_type": schema.StringAttribute{
 									MarkdownDescription: "EBS volume type (gp2, gp3, io1, io2, etc.).",
 									Optional:            true,
 								},
-								"volume_size": schema.Int64Attribute{
-									MarkdownDescription: "Volume size in bytes.",
-									Optional:            true,
+								"volume_size": schema.StringAttribute{
+									MarkdownDescription: "Size of the restored volume. Must be at least the size of the source volume. " + sizeDescription,
+									CustomType:          SizeType{},
+									Required:            true,
+									Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 								},
 								"iops": schema.Int64Attribute{
 									MarkdownDescription: "IOPS for volume (required for io1/io2).",
@@ -315,22 +459,27 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"rds_config": schema.SingleNestedBlock{
 				MarkdownDescription: "RDS database restore configuration. Required when restoring AWS RDS database.",
+				PlanModifiers:       []planmodifier.Object{restoreConfigRequiresReplace()},
 				Attributes: map[string]schema.Attribute{
 					"db_instance_identifier": schema.StringAttribute{
 						MarkdownDescription: "Name to assign to the restored resource.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"db_instance_class": schema.StringAttribute{
-						MarkdownDescription: "DB instance class (for example, db.t3.micro).",
+						MarkdownDescription: "DB instance class (for example, db.t3.micro). The Eon API doesn't accept this setting, so the value is ignored.",
 						Optional:            true,
+						DeprecationMessage:  "The Eon RDS restore API doesn't accept an instance class, so this value is ignored. Remove it from the configuration.",
 					},
 					"engine": schema.StringAttribute{
-						MarkdownDescription: "Database engine (for example, mysql, postgres).",
+						MarkdownDescription: "Database engine (for example, mysql, postgres). The Eon API doesn't accept this setting, so the value is ignored.",
 						Optional:            true,
+						DeprecationMessage:  "The Eon RDS restore API doesn't accept an engine, so this value is ignored. Remove it from the configuration.",
 					},
 					"region": schema.StringAttribute{
 						MarkdownDescription: "Region to restore to.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"subnet_group_name": schema.StringAttribute{
 						MarkdownDescription: "Subnet group ID to associate with the restored resource. Must be in the same VPC of `vpc_security_group_ids`.",
@@ -341,33 +490,35 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 						ElementType:         types.StringType,
 						Optional:            true,
 					},
-					"allocated_storage": schema.Int64Attribute{
-						MarkdownDescription: "Allocated storage in GiB.",
+					"allocated_storage": schema.StringAttribute{
+						MarkdownDescription: "Allocated storage. " + sizeDescription + " Not supported by the Eon API yet; setting it fails validation.",
+						CustomType:          SizeType{},
 						Optional:            true,
 					},
 					"storage_type": schema.StringAttribute{
-						MarkdownDescription: "Storage type (gp2, gp3, io1, etc.).",
+						MarkdownDescription: "Storage type (gp2, gp3, io1, etc.). Not supported by the Eon API yet; setting it fails validation.",
 						Optional:            true,
 					},
 					"backup_retention_period": schema.Int64Attribute{
-						MarkdownDescription: "Backup retention period in days.",
+						MarkdownDescription: "Backup retention period in days. Not supported by the Eon API yet; setting it fails validation.",
 						Optional:            true,
 					},
 					"multi_az": schema.BoolAttribute{
-						MarkdownDescription: "Whether to enable Multi-AZ deployment.",
+						MarkdownDescription: "Whether to enable Multi-AZ deployment. Not supported by the Eon API yet; setting it fails validation.",
 						Optional:            true,
 					},
 					"publicly_accessible": schema.BoolAttribute{
-						MarkdownDescription: "Whether the database is publicly accessible.",
+						MarkdownDescription: "Whether the database is publicly accessible. Not supported by the Eon API yet; setting it fails validation.",
 						Optional:            true,
 					},
 					"storage_encrypted": schema.BoolAttribute{
-						MarkdownDescription: "Whether to enable storage encryption.",
+						MarkdownDescription: "Whether to enable storage encryption. Not supported by the Eon API yet; setting it fails validation.",
 						Optional:            true,
 					},
 					"kms_key_id": schema.StringAttribute{
 						MarkdownDescription: "ID of the key you want Eon to use for encrypting the restored resource.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"tags": schema.MapAttribute{
 						MarkdownDescription: "Tags to apply to the restored instance as key-value pairs, where key and value are both strings.",
@@ -378,10 +529,12 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"s3_bucket_config": schema.SingleNestedBlock{
 				MarkdownDescription: "S3 bucket restore configuration. Required when restoring AWS S3 bucket with `full` restore type.",
+				PlanModifiers:       []planmodifier.Object{restoreConfigRequiresReplace()},
 				Attributes: map[string]schema.Attribute{
 					"bucket_name": schema.StringAttribute{
 						MarkdownDescription: "Name of an existing bucket to restore the data to.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"key_prefix": schema.StringAttribute{
 						MarkdownDescription: "Prefix to add to the restore path. If you don't specify a prefix, the files are restored to their respective folders in the original file tree, starting from the root of the bucket.",
@@ -395,10 +548,12 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"s3_file_config": schema.SingleNestedBlock{
 				MarkdownDescription: "S3 file restore configuration. Required when restoring AWS S3 files with partial restore type.",
+				PlanModifiers:       []planmodifier.Object{restoreConfigRequiresReplace()},
 				Attributes: map[string]schema.Attribute{
 					"bucket_name": schema.StringAttribute{
 						MarkdownDescription: "Name of an existing bucket to restore the files to.",
-						Optional:            true,
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
 					},
 					"key_prefix": schema.StringAttribute{
 						MarkdownDescription: "Prefix to add to the restore path. If you don't specify a prefix, the files are restored to their respective folders in the original file tree, starting from the root of the bucket.",
@@ -412,12 +567,81 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 				Blocks: map[string]schema.Block{
 					"files": schema.ListNestedBlock{
 						MarkdownDescription: "List of file paths to restore.",
+						Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"path": schema.StringAttribute{
-									MarkdownDescription: "Absolute path to the file or directory to restore.",
+									MarkdownDescription: "Absolute path to the file or directory to restore. Paths are matched exactly; glob patterns such as `*` aren't expanded. To restore everything under a path, set `is_directory` to `true`.",
+									Required:            true,
+									Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+								},
+								"is_directory": schema.BoolAttribute{
+									MarkdownDescription: "Whether `path` is a directory. If `true`, Eon restores all files in all subdirectories under the path. If `false`, Eon restores only the file at the path.",
 									Optional:            true,
 								},
+							},
+						},
+					},
+				},
+			},
+			"azure_blob_config": schema.SingleNestedBlock{
+				MarkdownDescription: "Azure Blob Storage restore configuration. Required when restoring Azure storage account with `full` restore type. The restore account must be an Azure subscription.",
+				PlanModifiers:       []planmodifier.Object{restoreConfigRequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"storage_account_name": schema.StringAttribute{
+						MarkdownDescription: "Name of an existing storage account to restore the data to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"resource_group": schema.StringAttribute{
+						MarkdownDescription: "Name of the resource group that contains the storage account.",
+						Optional:            true,
+					},
+					"container": schema.StringAttribute{
+						MarkdownDescription: "Name of the container in the storage account to restore the data to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"prefix": schema.StringAttribute{
+						MarkdownDescription: "Prefix to add to the restore path. If you don't specify a prefix, the data is restored to its respective folders in the original file tree, starting from the root of the container.",
+						Optional:            true,
+					},
+				},
+			},
+			"azure_blob_file_config": schema.SingleNestedBlock{
+				MarkdownDescription: "Azure Blob Storage file restore configuration. Required when restoring Azure storage account files with `partial` restore type. The restore account must be an Azure subscription.",
+				PlanModifiers:       []planmodifier.Object{restoreConfigRequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"storage_account_name": schema.StringAttribute{
+						MarkdownDescription: "Name of an existing storage account to restore the data to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"resource_group": schema.StringAttribute{
+						MarkdownDescription: "Name of the resource group that contains the storage account.",
+						Optional:            true,
+					},
+					"container": schema.StringAttribute{
+						MarkdownDescription: "Name of the container in the storage account to restore the data to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"prefix": schema.StringAttribute{
+						MarkdownDescription: "Prefix to add to the restore path. If you don't specify a prefix, the data is restored to its respective folders in the original file tree, starting from the root of the container.",
+						Optional:            true,
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"files": schema.ListNestedBlock{
+						MarkdownDescription: "List of file paths to restore.",
+						Validators:          []validator.List{listvalidator.SizeAtLeast(1)},
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"path": schema.StringAttribute{
+									MarkdownDescription: "Absolute path to the file or directory to restore. Paths are matched exactly; glob patterns such as `*` aren't expanded. To restore everything under a path, set `is_directory` to `true`.",
+									Required:            true,
+									Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+								},
 								"is_directory": schema.BoolAttribute{
 									MarkdownDescription: "Whether `path` is a directory. If `true`, Eon restores all files in all subdirectories under the path. If `false`, Eon restores only the file at the path.",
 									Optional:            true,
@@ -427,6 +651,62 @@ func (r *RestoreJobResource) Schema(ctx context.Context, req resource.SchemaRequ
 					},
 				},
 			},
+			"dynamodb_config": schema.SingleNestedBlock{
+				MarkdownDescription: "DynamoDB table restore configuration. Required when restoring AWS DynamoDB table.",
+				PlanModifiers:       []planmodifier.Object{restoreConfigRequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"table_name": schema.StringAttribute{
+						MarkdownDescription: "Name to assign to the restored table.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Region to restore the table to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"kms_key_id": schema.StringAttribute{
+						MarkdownDescription: "ID of the key you want Eon to use for encrypting the restored table.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"write_capacity_units": schema.Int64Attribute{
+						MarkdownDescription: "Number of write capacity units for the restored table. If not specified, Eon uses 5.",
+						Optional:            true,
+					},
+					"tags": schema.MapAttribute{
+						MarkdownDescription: "Tags to apply to the restored table as key-value pairs, where key and value are both strings.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// restoredResourceAttributes returns the attributes describing a resource created by a restore job
+func restoredResourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"resource_type": schema.StringAttribute{
+			MarkdownDescription: "Type of the restored resource. Possible values: `AWS_EC2_INSTANCE`, `AWS_EBS_VOLUME`, `AZURE_DISK`.",
+			Computed:            true,
+		},
+		"provider_resource_id": schema.StringAttribute{
+			MarkdownDescription: "Cloud-provider-assigned ID of the restored resource.",
+			Computed:            true,
+		},
+		"cloud_provider": schema.StringAttribute{
+			MarkdownDescription: "Cloud provider of the restore account.",
+			Computed:            true,
+		},
+		"provider_account_id": schema.StringAttribute{
+			MarkdownDescription: "Cloud-provider-assigned ID of the account the resource was restored to.",
+			Computed:            true,
+		},
+		"region": schema.StringAttribute{
+			MarkdownDescription: "Region the resource was restored to.",
+			Computed:            true,
 		},
 	}
 }
@@ -450,74 +730,113 @@ func (r *RestoreJobResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	snapshot, err := r.client.GetSnapshot(ctx, data.SnapshotId.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to retrieve snapshot with ID %s: %s", data.SnapshotId.ValueString(), err))
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, restoreJobWaitTimeout(data))
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.createRestoreJob(ctx, &data, restoreJobLayout, createTimeout)...)
+	if !isKnownString(data.JobId) {
 		return
 	}
 
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// createRestoreJob validates the restore against the snapshot it uses, starts the restore job, and waits for it
+// when wait_for_completion is set. data.JobId is known once the job has started.
+func (r *RestoreJobResource) createRestoreJob(ctx context.Context, data *RestoreJobResourceModel, layout restoreLayout, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	snapshot, snapshotDiags := r.resolveRestoreSnapshot(ctx, *data)
+	diags.Append(snapshotDiags...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.SnapshotId = types.StringValue(snapshot.Id)
+	if snapshot.PointInTime != nil {
+		data.RecoveryPointTime = types.StringValue(snapshot.PointInTime.Format(time.RFC3339))
+	} else {
+		data.RecoveryPointTime = types.StringNull()
+	}
+
 	// Set resource_id from snapshot
 	resourceId := snapshot.GetResourceId()
 	data.ResourceId = types.StringValue(resourceId)
 
 	inventoryResource, err := r.client.GetResourceById(ctx, resourceId)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to retrieve resource with ID %s: %s", resourceId, err))
-		return
+		diags.AddError("Client Error", fmt.Sprintf("Unable to retrieve resource with ID %s: %s", resourceId, err))
+		return diags
 	}
 
 	restoreType := data.RestoreType.ValueString()
-	if restoreType != "full" && restoreType != "partial" {
-		resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Invalid restore_type: %s. Supported types: full, partial", restoreType))
-		return
+	diags.Append(layout.validateConfig(*data, inventoryResource.GetResourceType())...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := r.validateRestoreAccount(ctx, data.RestoreAccountId.ValueString(), inventoryResource.GetCloudProvider()); err != nil {
+		diags.AddError("Configuration Error", err.Error())
+		return diags
 	}
 	var jobId string
 
-	// Fallback to inventory-based detection for backward compatibility
 	switch inventoryResource.GetResourceType() {
 	case externalEonSdkAPI.AWS_EC2:
 		if restoreType == "partial" {
-			if data.EbsConfig == nil {
-
-				resp.Diagnostics.AddError("Configuration Error", "ebs_config is required when restoring AWS EC2 volumes with restore_type 'partial'")
-				return
+			if missing := missingSnapshotVolumeIds(snapshot, []string{data.EbsConfig.ProviderVolumeId.ValueString()}); len(missing) > 0 {
+				diags.AddAttributeError(layout.configPath("ebs_config").AtName("provider_volume_id"), "Configuration Error", fmt.Sprintf("provider_volume_id %s isn't a volume in snapshot %s", missing[0], snapshot.Id))
+				return diags
 			}
-			jobId, err = r.createEbsVolumeRestore(ctx, data, resourceId)
+			jobId, err = r.createEbsVolumeRestore(ctx, *data, resourceId)
 		} else {
-			if data.Ec2Config == nil {
-				resp.Diagnostics.AddError("Configuration Error", "ec2_config is required when restoring AWS EC2 instances with restore_type 'full'")
-				return
+			var volumeParams []VolumeRestoreParam
+			if !data.Ec2Config.VolumeRestoreParams.IsNull() {
+				diags.Append(data.Ec2Config.VolumeRestoreParams.ElementsAs(ctx, &volumeParams, false)...)
+				if diags.HasError() {
+					return diags
+				}
 			}
-			jobId, err = r.createEc2InstanceRestore(ctx, data, resourceId)
+			volumeIds := make([]string, 0, len(volumeParams))
+			for _, volumeParam := range volumeParams {
+				volumeIds = append(volumeIds, volumeParam.ProviderVolumeId.ValueString())
+			}
+			if missing := missingSnapshotVolumeIds(snapshot, volumeIds); len(missing) > 0 {
+				diags.AddAttributeError(layout.configPath("ec2_config").AtName("volume_restore_params"), "Configuration Error", fmt.Sprintf("provider_volume_id values %v aren't volumes in snapshot %s", missing, snapshot.Id))
+				return diags
+			}
+			jobId, err = r.createEc2InstanceRestore(ctx, *data, resourceId)
 		}
 	case externalEonSdkAPI.AWS_RDS:
-		if data.RdsConfig == nil {
-			resp.Diagnostics.AddError("Configuration Error", "rds_config is required when restoring AWS RDS databases")
-			return
-		}
-		jobId, err = r.createRdsRestore(ctx, data, resourceId)
+		jobId, err = r.createRdsRestore(ctx, *data, resourceId)
 	case externalEonSdkAPI.AWS_S3:
 		if restoreType == "full" {
-			if data.S3BucketConfig == nil {
-				resp.Diagnostics.AddError("Configuration Error", "s3_bucket_config is required when restoring AWS S3 buckets with restore_type 'full'")
-				return
-			}
-			jobId, err = r.createS3BucketRestore(ctx, data, resourceId)
+			jobId, err = r.createS3BucketRestore(ctx, *data, resourceId)
 		} else {
-			if data.S3FileConfig == nil {
-				resp.Diagnostics.AddError("Configuration Error", "s3_file_config is required when restoring AWS S3 files with restore_type 'partial'")
-				return
-			}
-			jobId, err = r.createS3FileRestore(ctx, data, resourceId)
+			jobId, err = r.createS3FileRestore(ctx, *data, resourceId)
+		}
+	case externalEonSdkAPI.AWS_DYNAMO_DB:
+		jobId, err = r.createDynamoDbRestore(ctx, *data, resourceId)
+	case externalEonSdkAPI.AZURE_STORAGE_ACCOUNT:
+		if restoreType == "full" {
+			jobId, err = r.createAzureBlobRestore(ctx, *data, resourceId)
+		} else {
+			jobId, err = r.createAzureBlobFileRestore(ctx, *data, resourceId)
 		}
 	default:
-		resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Unsupported resource type: %s. Supported types: AWS_EC2, AWS_RDS, AWS_S3. Please provide one of: ebs_config, ec2_config, rds_config, s3_bucket_config, or s3_file_config", inventoryResource.GetResourceType()))
-		return
+		// validateRestoreConfig rejects resource types that can't be restored
+		diags.AddError("Configuration Error", fmt.Sprintf("Unsupported resource type: %s", inventoryResource.GetResourceType()))
+		return diags
 	}
 
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to start restore job: %s", err))
-		return
+		diags.AddError("Client Error", fmt.Sprintf("Unable to start restore job: %s", err))
+		return diags
 	}
 
 	data.JobId = types.StringValue(jobId)
@@ -536,43 +855,109 @@ func (r *RestoreJobResource) Create(ctx context.Context, req resource.CreateRequ
 	data.StartedAt = types.StringNull()
 	data.CompletedAt = types.StringNull()
 	data.DurationSeconds = types.Int64Null()
+	data.RestoredResources = types.ListValueMust(types.ObjectType{AttrTypes: restoredResourceAttrTypes}, []attr.Value{})
+	data.RestoredVolumeId = types.StringNull()
+	data.RestoredInstanceId = types.StringNull()
+	data.RestoredDiskId = types.StringNull()
 
-	// Wait for completion if requested
+	// Wait for completion if requested. A restore that fails fails the apply, so the next apply starts it again.
 	if data.WaitForCompletion.ValueBool() {
-		timeout := time.Duration(data.TimeoutMinutes.ValueInt64()) * time.Minute
-		finalJob, err := r.client.WaitForRestoreJobCompletion(ctx, jobId, timeout)
+		diags.Append(r.waitForRestoreJob(ctx, data, timeout, diag.SeverityError)...)
+	}
+
+	return diags
+}
+
+// resolveRestoreSnapshot returns the snapshot_id snapshot, or the latest snapshot of resource_id taken
+// at or before point_in_time
+func (r *RestoreJobResource) resolveRestoreSnapshot(ctx context.Context, data RestoreJobResourceModel) (*externalEonSdkAPI.Snapshot, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hasSnapshotId := !data.SnapshotId.IsNull() && !data.SnapshotId.IsUnknown() && data.SnapshotId.ValueString() != ""
+	hasPointInTime := !data.PointInTime.IsNull() && data.PointInTime.ValueString() != ""
+
+	switch {
+	case hasSnapshotId && hasPointInTime:
+		diags.AddError("Configuration Error", "Only one of snapshot_id or point_in_time can be set")
+		return nil, diags
+
+	case hasSnapshotId:
+		snapshot, err := r.client.GetSnapshot(ctx, data.SnapshotId.ValueString())
 		if err != nil {
-			tflog.Warn(ctx, "Restore job may still be running", map[string]interface{}{"error": err.Error()})
-			data.StatusMessage = types.StringValue(err.Error())
-			data.Status = types.StringValue("JOB_FAILED")
+			diags.AddError("Client Error", fmt.Sprintf("Unable to retrieve snapshot with ID %s: %s", data.SnapshotId.ValueString(), err))
+			return nil, diags
+		}
+		return snapshot, diags
 
-			// Try to get the actual job status to fill in details
-			if actualJob, getErr := r.client.GetRestoreJob(ctx, jobId); getErr == nil {
-				r.updateJobStatus(ctx, &data, actualJob)
-			}
-		} else {
-			r.updateJobStatus(ctx, &data, finalJob)
+	case hasPointInTime:
+		if data.ResourceId.IsNull() || data.ResourceId.IsUnknown() || data.ResourceId.ValueString() == "" {
+			diags.AddError("Configuration Error", "resource_id is required when point_in_time is set")
+			return nil, diags
 		}
-	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		pointInTime, err := time.Parse(time.RFC3339, data.PointInTime.ValueString())
+		if err != nil {
+			diags.AddError("Configuration Error", fmt.Sprintf("Invalid point_in_time %q, expected RFC 3339 format such as 2024-01-02T15:04:05Z: %s", data.PointInTime.ValueString(), err))
+			return nil, diags
+		}
+
+		resourceId := data.ResourceId.ValueString()
+		snapshot, err := r.client.GetSnapshotAtPointInTime(ctx, resourceId, pointInTime)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to find a snapshot of resource %s at %s: %s", resourceId, data.PointInTime.ValueString(), err))
+			return nil, diags
+		}
+		if snapshot == nil {
+			diags.AddError("Client Error", fmt.Sprintf("Resource %s has no snapshot taken at or before %s", resourceId, data.PointInTime.ValueString()))
+			return nil, diags
+		}
+
+		tflog.Debug(ctx, "Resolved point in time to snapshot", map[string]interface{}{
+			"resource_id":   resourceId,
+			"point_in_time": data.PointInTime.ValueString(),
+			"snapshot_id":   snapshot.Id,
+		})
+		return snapshot, diags
+
+	default:
+		diags.AddError("Configuration Error", "Either snapshot_id or point_in_time must be set")
+		return nil, diags
+	}
 }
 
-func (r *RestoreJobResource) createEbsVolumeRestore(ctx context.Context, data RestoreJobResourceModel, resourceId string) (string, error) {
-	config := data.EbsConfig
+// validateRestoreAccount checks that the restore account is connected and in the same cloud as the resource being restored
+func (r *RestoreJobResource) validateRestoreAccount(ctx context.Context, restoreAccountId string, cloudProvider externalEonSdkAPI.Provider) error {
+	account, err := r.client.GetRestoreAccount(ctx, restoreAccountId)
+	if errors.Is(err, client.ErrNotFound) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("unable to read restore account: %w", err)
+	}
 
-	// Validate required fields for EBS volume restore
-	if config.ProviderVolumeId.IsNull() || config.ProviderVolumeId.ValueString() == "" {
-		return "", fmt.Errorf("provider_volume_id is required for EBS volume restore")
+	if account.Status != externalEonSdkAPI.ACCOUNT_STATE_CONNECTED {
+		return fmt.Errorf("restore account %s is in status %s. Reconnect it before restoring to it", restoreAccountId, account.Status)
 	}
-	if config.AvailabilityZone.IsNull() || config.AvailabilityZone.ValueString() == "" {
-		return "", fmt.Errorf("availability_zone is required for EBS volume restore")
+	if !account.RestoreAccountAttributes.HasCloudProvider() {
+		return nil
 	}
-	if config.VolumeType.IsNull() || config.VolumeType.ValueString() == "" {
-		return "", fmt.Errorf("volume_type is required for EBS volume restore")
+	if accountProvider := account.RestoreAccountAttributes.GetCloudProvider(); accountProvider != cloudProvider {
+		return fmt.Errorf("restore account %s is a %s account, but the resource being restored is in %s. Use a %s restore account", restoreAccountId, accountProvider, cloudProvider, cloudProvider)
 	}
-	if config.VolumeSize.IsNull() || config.VolumeSize.ValueInt64() == 0 {
-		return "", fmt.Errorf("volume_size is required for EBS volume restore")
+	return nil
+}
+
+// restoreJobWaitTimeout returns the deprecated timeout_minutes value, used when the timeouts block doesn't set one
+func restoreJobWaitTimeout(data RestoreJobResourceModel) time.Duration {
+	return time.Duration(data.TimeoutMinutes.ValueInt64()) * time.Minute
+}
+
+func (r *RestoreJobResource) createEbsVolumeRestore(ctx context.Context, data RestoreJobResourceModel, resourceId string) (string, error) {
+	config := data.EbsConfig
+
+	volumeSizeBytes, err := config.VolumeSize.Bytes()
+	if err != nil {
+		return "", fmt.Errorf("invalid volume_size: %w", err)
 	}
 
 	var tags map[string]string
@@ -591,7 +976,7 @@ func (r *RestoreJobResource) createEbsVolumeRestore(ctx context.Context, data Re
 	// Build volume settings
 	volumeSettings := externalEonSdkAPI.VolumeSettings{
 		Type:      config.VolumeType.ValueString(),
-		SizeBytes: config.VolumeSize.ValueInt64(),
+		SizeBytes: volumeSizeBytes,
 	}
 
 	if !config.Iops.IsNull() {
@@ -638,20 +1023,6 @@ func (r *RestoreJobResource) createEbsVolumeRestore(ctx context.Context, data Re
 func (r *RestoreJobResource) createEc2InstanceRestore(ctx context.Context, data RestoreJobResourceModel, resourceId string) (string, error) {
 	config := data.Ec2Config
 
-	// Validate required fields for EC2 instance restore
-	if config.Region.IsNull() || config.Region.ValueString() == "" {
-		return "", fmt.Errorf("region is required for EC2 instance restore")
-	}
-	if config.InstanceType.IsNull() || config.InstanceType.ValueString() == "" {
-		return "", fmt.Errorf("instance_type is required for EC2 instance restore")
-	}
-	if config.SubnetId.IsNull() || config.SubnetId.ValueString() == "" {
-		return "", fmt.Errorf("subnet_id is required for EC2 instance restore")
-	}
-	if config.VolumeRestoreParams.IsNull() || len(config.VolumeRestoreParams.Elements()) == 0 {
-		return "", fmt.Errorf("volume_restore_params is required for EC2 instance restore")
-	}
-
 	var tags map[string]string
 	if !config.Tags.IsNull() {
 		tagsMap := make(map[string]types.String, len(config.Tags.Elements()))
@@ -686,9 +1057,14 @@ func (r *RestoreJobResource) createEc2InstanceRestore(ctx context.Context, data
 		}
 
 		for _, volParam := range volParams {
+			volumeSizeBytes, err := volParam.VolumeSize.Bytes()
+			if err != nil {
+				return "", fmt.Errorf("invalid volume_size for volume %s: %w", volParam.ProviderVolumeId.ValueString(), err)
+			}
+
 			volumeSettings := externalEonSdkAPI.VolumeSettings{
 				Type:      volParam.VolumeType.ValueString(),
-				SizeBytes: volParam.VolumeSize.ValueInt64() * 1024 * 1024 * 1024, // Convert GiB to bytes
+				SizeBytes: volumeSizeBytes,
 			}
 
 			if !volParam.Iops.IsNull() {
@@ -751,22 +1127,10 @@ func (r *RestoreJobResource) createEc2InstanceRestore(ctx context.Context, data
 func (r *RestoreJobResource) createRdsRestore(ctx context.Context, data RestoreJobResourceModel, resourceId string) (string, error) {
 	config := data.RdsConfig
 
-	// Validate required fields for RDS restore
-	if config.DbInstanceIdentifier.IsNull() || config.DbInstanceIdentifier.ValueString() == "" {
-		return "", fmt.Errorf("db_instance_identifier is required for RDS restore")
-	}
-	if config.DbInstanceClass.IsNull() || config.DbInstanceClass.ValueString() == "" {
-		return "", fmt.Errorf("db_instance_class is required for RDS restore")
-	}
-	if config.Engine.IsNull() || config.Engine.ValueString() == "" {
-		return "", fmt.Errorf("engine is required for RDS restore")
-	}
-	if config.Region.IsNull() || config.Region.ValueString() == "" {
-		return "", fmt.Errorf("region is required for RDS restore")
-	}
-
-	if config.KmsKeyId.IsNull() || config.KmsKeyId.ValueString() == "" {
-		return "", fmt.Errorf("kms_key_id is required for RDS restore")
+	// The Eon API has no field for these settings, so reject them rather than silently dropping them. Configuration
+	// validation already rejects them, so this only guards against callers that skip it.
+	if unsupported := unsupportedRdsRestoreAttributes(config); len(unsupported) > 0 {
+		return "", fmt.Errorf("rds_config attributes %s aren't supported by the Eon RDS restore API. Remove them from the configuration", strings.Join(unsupported, ", "))
 	}
 
 	var tags map[string]string
@@ -821,14 +1185,33 @@ func (r *RestoreJobResource) createRdsRestore(ctx context.Context, data RestoreJ
 	return r.client.StartRdsRestore(ctx, resourceId, data.SnapshotId.ValueString(), apiReq)
 }
 
+// unsupportedRdsRestoreAttributes lists the rds_config attributes that are set but can't be sent to the Eon API
+func unsupportedRdsRestoreAttributes(config *RdsRestoreConfig) []string {
+	var unsupported []string
+	if !config.AllocatedStorage.IsNull() {
+		unsupported = append(unsupported, "allocated_storage")
+	}
+	if !config.StorageType.IsNull() {
+		unsupported = append(unsupported, "storage_type")
+	}
+	if !config.BackupRetentionPeriod.IsNull() {
+		unsupported = append(unsupported, "backup_retention_period")
+	}
+	if !config.MultiAz.IsNull() {
+		unsupported = append(unsupported, "multi_az")
+	}
+	if !config.PubliclyAccessible.IsNull() {
+		unsupported = append(unsupported, "publicly_accessible")
+	}
+	if !config.StorageEncrypted.IsNull() {
+		unsupported = append(unsupported, "storage_encrypted")
+	}
+	return unsupported
+}
+
 func (r *RestoreJobResource) createS3BucketRestore(ctx context.Context, data RestoreJobResourceModel, resourceId string) (string, error) {
 	config := data.S3BucketConfig
 
-	// Validate required fields for S3 bucket restore
-	if config.BucketName.IsNull() || config.BucketName.ValueString() == "" {
-		return "", fmt.Errorf("bucket_name is required for S3 bucket restore")
-	}
-
 	// Build S3 restore target - use the actual SDK structure
 	s3Target := &externalEonSdkAPI.S3RestoreTarget{
 		BucketName: config.BucketName.ValueString(),
@@ -856,33 +1239,9 @@ func (r *RestoreJobResource) createS3BucketRestore(ctx context.Context, data Res
 func (r *RestoreJobResource) createS3FileRestore(ctx context.Context, data RestoreJobResourceModel, resourceId string) (string, error) {
 	config := data.S3FileConfig
 
-	// Validate required fields for S3 file restore
-	if config.BucketName.IsNull() || config.BucketName.ValueString() == "" {
-		return "", fmt.Errorf("bucket_name is required for S3 file restore")
-	}
-	if config.Files.IsNull() || len(config.Files.Elements()) == 0 {
-		return "", fmt.Errorf("files is required for S3 file restore")
-	}
-
-	var files []externalEonSdkAPI.FilePath
-	if !config.Files.IsNull() {
-		var fileList []S3FileParam
-		diags := config.Files.ElementsAs(ctx, &fileList, false)
-		if diags.HasError() {
-			return "", fmt.Errorf("failed to parse files list")
-		}
-
-		for _, file := range fileList {
-			filePath := externalEonSdkAPI.FilePath{
-				Path: file.Path.ValueString(),
-			}
-			if !file.IsDirectory.IsNull() {
-				filePath.IsDirectory = file.IsDirectory.ValueBool()
-			} else {
-				filePath.IsDirectory = false
-			}
-			files = append(files, filePath)
-		}
+	files, err := restoreFilePaths(ctx, config.Files)
+	if err != nil {
+		return "", err
 	}
 
 	s3Target := &externalEonSdkAPI.S3RestoreTarget{
@@ -909,74 +1268,1172 @@ func (r *RestoreJobResource) createS3FileRestore(ctx context.Context, data Resto
 	return r.client.StartS3FileRestore(ctx, resourceId, data.SnapshotId.ValueString(), apiReq)
 }
 
-func (r *RestoreJobResource) updateJobStatus(ctx context.Context, data *RestoreJobResourceModel, job *externalEonSdkAPI.RestoreJob) {
-	data.Status = types.StringValue(string(job.GetJobExecutionDetails().Status))
-	data.CreatedAt = types.StringValue(job.GetJobExecutionDetails().CreatedTime.Format(time.RFC3339))
+func (r *RestoreJobResource) createAzureBlobRestore(ctx context.Context, data RestoreJobResourceModel, resourceId string) (string, error) {
+	config := data.AzureBlobConfig
 
-	if job.GetJobExecutionDetails().StatusMessage != nil {
-		data.StatusMessage = types.StringValue(*job.GetJobExecutionDetails().StatusMessage)
-	} else {
-		data.StatusMessage = types.StringNull()
+	apiReq := externalEonSdkAPI.RestoreBucketRequest{
+		RestoreAccountId: data.RestoreAccountId.ValueString(),
+		Destination: externalEonSdkAPI.ObjectStorageDestination{
+			StorageAccount: newStorageAccountRestoreTarget(config.StorageAccountName, config.ResourceGroup, config.Container, config.Prefix),
+		},
 	}
 
-	if job.GetJobExecutionDetails().StartTime.IsSet() {
-		data.StartedAt = types.StringValue(job.GetJobExecutionDetails().StartTime.Get().Format(time.RFC3339))
-	} else {
-		data.StartedAt = types.StringNull()
-	}
+	return r.client.StartAzureBlobRestore(ctx, resourceId, data.SnapshotId.ValueString(), apiReq)
+}
 
-	if job.GetJobExecutionDetails().EndTime.IsSet() {
-		data.CompletedAt = types.StringValue(job.GetJobExecutionDetails().EndTime.Get().Format(time.RFC3339))
-	} else {
-		data.CompletedAt = types.StringNull()
+func (r *RestoreJobResource) createAzureBlobFileRestore(ctx context.Context, data RestoreJobResourceModel, resourceId string) (string, error) {
+	config := data.AzureBlobFileConfig
+
+	files, err := restoreFilePaths(ctx, config.Files)
+	if err != nil {
+		return "", err
 	}
 
-	if job.GetJobExecutionDetails().DurationSeconds.IsSet() {
-		data.DurationSeconds = types.Int64Value(*job.GetJobExecutionDetails().DurationSeconds.Get())
-	} else {
-		data.DurationSeconds = types.Int64Null()
+	apiReq := externalEonSdkAPI.RestoreFilesRequest{
+		RestoreAccountId: data.RestoreAccountId.ValueString(),
+		Files:            files,
+		Destination: externalEonSdkAPI.ObjectStorageDestination{
+			StorageAccount: newStorageAccountRestoreTarget(config.StorageAccountName, config.ResourceGroup, config.Container, config.Prefix),
+		},
 	}
+
+	return r.client.StartAzureBlobFileRestore(ctx, resourceId, data.SnapshotId.ValueString(), apiReq)
 }
 
-func (r *RestoreJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data RestoreJobResourceModel
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+func newStorageAccountRestoreTarget(name, resourceGroup, container, prefix types.String) *externalEonSdkAPI.StorageAccountRestoreTarget {
+	target := &externalEonSdkAPI.StorageAccountRestoreTarget{
+		Name:      name.ValueString(),
+		Container: container.ValueString(),
 	}
 
-	if data.JobId.IsNull() || data.JobId.ValueString() == "" {
-		return
+	if !resourceGroup.IsNull() {
+		resourceGroupName := resourceGroup.ValueString()
+		target.ResourceGroup = &resourceGroupName
 	}
-
-	job, err := r.client.GetRestoreJob(ctx, data.JobId.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore job: %s", err))
-		return
+	if !prefix.IsNull() {
+		prefixValue := prefix.ValueString()
+		target.Prefix = &prefixValue
 	}
 
-	r.updateJobStatus(ctx, &data, job)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return target
 }
 
-func (r *RestoreJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var data RestoreJobResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
-	if resp.Diagnostics.HasError() {
-		return
+// missingSnapshotVolumeIds returns the volume IDs that aren't volumes of the snapshotted EC2 instance.
+// It returns nil if the snapshot doesn't list its volumes.
+func missingSnapshotVolumeIds(snapshot *externalEonSdkAPI.Snapshot, volumeIds []string) []string {
+	volumes := snapshotVolumes(snapshot)
+	if len(volumes) == 0 {
+		return nil
 	}
+
+	snapshotVolumeIds := make(map[string]bool, len(volumes))
+	for _, volume := range volumes {
+		snapshotVolumeIds[volume.ProviderVolumeId] = true
+	}
+
+	var missing []string
+	for _, volumeId := range volumeIds {
+		// Empty IDs are reported by the required field checks
+		if volumeId != "" && !snapshotVolumeIds[volumeId] {
+			missing = append(missing, volumeId)
+		}
+	}
+	return missing
+}
+
+// snapshotVolumes returns the volumes recorded in an EC2 instance snapshot, or nil if the snapshot doesn't list them
+func snapshotVolumes(snapshot *externalEonSdkAPI.Snapshot) []externalEonSdkAPI.InventorySnapshotVolume {
+	resourceSnapshot, ok := snapshot.GetResourceOk()
+	if !ok || resourceSnapshot == nil {
+		return nil
+	}
+	properties, ok := resourceSnapshot.GetPropertiesOk()
+	if !ok || properties == nil {
+		return nil
+	}
+	ec2Properties, ok := properties.GetAwsEc2Ok()
+	if !ok || ec2Properties == nil {
+		return nil
+	}
+	return ec2Properties.GetVolumes()
+}
+
+// undersizedVolumeError returns an error message if size is smaller than the snapshotted volume, or an empty string
+// if it isn't or the volume's size isn't known
+func undersizedVolumeError(snapshot *externalEonSdkAPI.Snapshot, volumeId string, size SizeValue) string {
+	if size.IsNull() || size.IsUnknown() {
+		return ""
+	}
+	sizeBytes, err := size.Bytes()
+	if err != nil {
+		// Invalid sizes are reported by the size type's validation
+		return ""
+	}
+
+	for _, volume := range snapshotVolumes(snapshot) {
+		if volume.ProviderVolumeId != volumeId {
+			continue
+		}
+		sourceBytes := volume.VolumeSettings.SizeBytes
+		if sourceBytes > 0 && sizeBytes < sourceBytes {
+			return fmt.Sprintf("volume_size %s is smaller than the %s source volume %s in snapshot %s. Restored volumes can't be smaller than the source volume.",
+				formatSize(sizeBytes), formatSize(sourceBytes), volumeId, snapshot.Id)
+		}
+		return ""
+	}
+	return ""
+}
+
+// restoreFilePaths converts a files block list into the file paths sent to the restore API
+func restoreFilePaths(ctx context.Context, filesList types.List) ([]externalEonSdkAPI.FilePath, error) {
+	var files []externalEonSdkAPI.FilePath
+	if filesList.IsNull() {
+		return files, nil
+	}
+
+	var fileList []S3FileParam
+	diags := filesList.ElementsAs(ctx, &fileList, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to parse files list")
+	}
+
+	for _, file := range fileList {
+		filePath := externalEonSdkAPI.FilePath{
+			Path: file.Path.ValueString(),
+		}
+		if !file.IsDirectory.IsNull() {
+			filePath.IsDirectory = file.IsDirectory.ValueBool()
+		} else {
+			filePath.IsDirectory = false
+		}
+		files = append(files, filePath)
+	}
+
+	return files, nil
+}
+
+func (r *RestoreJobResource) createDynamoDbRestore(ctx context.Context, data RestoreJobResourceModel, resourceId string) (string, error) {
+	dynamoDbTarget, err := newDynamoDbRestoreTarget(ctx, data.DynamoDbConfig)
+	if err != nil {
+		return "", err
+	}
+
+	apiReq := externalEonSdkAPI.RestoreDynamoDBTableInput{
+		RestoreAccountId: data.RestoreAccountId.ValueString(),
+		Destination: externalEonSdkAPI.DynamodbTableRestoreDestination{
+			AwsDynamodb: dynamoDbTarget,
+		},
+	}
+
+	return r.client.StartDynamoDbRestore(ctx, resourceId, data.SnapshotId.ValueString(), apiReq)
+}
+
+// newDynamoDbRestoreTarget builds the DynamoDB restore destination. Write capacity is left unset when it isn't
+// configured, so Eon applies its default.
+func newDynamoDbRestoreTarget(ctx context.Context, config *DynamoDbRestoreConfig) (*externalEonSdkAPI.AwsDynamoDBDestination, error) {
+	dynamoDbTarget := &externalEonSdkAPI.AwsDynamoDBDestination{
+		RestoreRegion:   config.Region.ValueString(),
+		RestoredName:    config.TableName.ValueString(),
+		EncryptionKeyId: config.KmsKeyId.ValueString(),
+	}
+
+	if !config.WriteCapacityUnits.IsNull() {
+		writeCapacityUnits, err := SafeInt32Conversion(config.WriteCapacityUnits.ValueInt64())
+		if err != nil {
+			return nil, fmt.Errorf("invalid write_capacity_units: %w", err)
+		}
+		dynamoDbTarget.WriteCapacityUnits = &writeCapacityUnits
+	}
+
+	if !config.Tags.IsNull() {
+		tags := make(map[string]string, len(config.Tags.Elements()))
+		diags := config.Tags.ElementsAs(ctx, &tags, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("failed to parse tags")
+		}
+		dynamoDbTarget.Tags = &tags
+	}
+
+	return dynamoDbTarget, nil
+}
+
+// waitForRestoreJob waits for the job to finish and records its status. A job that is still running
+// when the timeout expires keeps its real status, so a later refresh can resume waiting on it. A job that
+// fails is reported with the given severity.
+func (r *RestoreJobResource) waitForRestoreJob(ctx context.Context, data *RestoreJobResourceModel, timeout time.Duration, failure diag.Severity) diag.Diagnostics {
+	var diags diag.Diagnostics
+	jobId := data.JobId.ValueString()
+
+	job, err := r.client.WaitForRestoreJobCompletion(ctx, jobId, timeout)
+	if err != nil && job == nil {
+		tflog.Warn(ctx, "Restore job may still be running", map[string]interface{}{"job_id": jobId, "error": err.Error()})
+
+		// Record the actual job status rather than assuming the job failed. The operation's
+		// deadline may already have passed, so this lookup isn't bound to it.
+		if actualJob, getErr := r.client.GetRestoreJob(context.WithoutCancel(ctx), jobId); getErr == nil {
+			diags.Append(r.updateJobStatus(ctx, data, actualJob)...)
+		}
+
+		if errors.Is(err, client.ErrRestoreJobWaitTimeout) {
+			diags.AddWarning(
+				"Restore Job Still Running",
+				fmt.Sprintf("Restore job %s didn't finish within %s and is in status %s. While wait_for_completion is true, the next plan or apply resumes waiting for it.", jobId, timeout, data.Status.ValueString()),
+			)
+		} else {
+			diags.AddWarning(
+				"Unable to Wait for Restore Job",
+				fmt.Sprintf("Stopped waiting for restore job %s in status %s: %s. While wait_for_completion is true, the next plan or apply resumes waiting for it.", jobId, data.Status.ValueString(), err),
+			)
+		}
+		return diags
+	}
+
+	diags.Append(r.updateJobStatus(ctx, data, job)...)
+
+	if err != nil {
+		details := "Eon didn't report why."
+		if job.GetJobExecutionDetails().StatusMessage != nil {
+			details = *job.GetJobExecutionDetails().StatusMessage
+		}
+		summary := "Restore Job Failed"
+		detail := fmt.Sprintf("Restore job %s finished with status %s: %s", jobId, data.Status.ValueString(), details)
+		if failure == diag.SeverityError {
+			diags.AddError(summary, detail)
+		} else {
+			diags.AddWarning(summary, detail)
+		}
+		return diags
+	}
+
+	if job.GetJobExecutionDetails().Status == externalEonSdkAPI.JOB_PARTIAL {
+		details := "Eon didn't report which items failed."
+		if job.GetJobExecutionDetails().StatusMessage != nil {
+			details = *job.GetJobExecutionDetails().StatusMessage
+		}
+		diags.AddWarning(
+			"Restore Job Partially Completed",
+			fmt.Sprintf("Restore job %s finished with status JOB_PARTIAL. Some items weren't restored: %s", jobId, details),
+		)
+	}
+
+	return diags
+}
+
+func (r *RestoreJobResource) updateJobStatus(ctx context.Context, data *RestoreJobResourceModel, job *externalEonSdkAPI.RestoreJob) diag.Diagnostics {
+	data.Status = types.StringValue(string(job.GetJobExecutionDetails().Status))
+	data.CreatedAt = types.StringValue(job.GetJobExecutionDetails().CreatedTime.Format(time.RFC3339))
+
+	if job.GetJobExecutionDetails().StatusMessage != nil {
+		data.StatusMessage = types.StringValue(*job.GetJobExecutionDetails().StatusMessage)
+	} else {
+		data.StatusMessage = types.StringNull()
+	}
+
+	if job.GetJobExecutionDetails().StartTime.IsSet() {
+		data.StartedAt = types.StringValue(job.GetJobExecutionDetails().StartTime.Get().Format(time.RFC3339))
+	} else {
+		data.StartedAt = types.StringNull()
+	}
+
+	if job.GetJobExecutionDetails().EndTime.IsSet() {
+		data.CompletedAt = types.StringValue(job.GetJobExecutionDetails().EndTime.Get().Format(time.RFC3339))
+	} else {
+		data.CompletedAt = types.StringNull()
+	}
+
+	if job.GetJobExecutionDetails().DurationSeconds.IsSet() {
+		data.DurationSeconds = types.Int64Value(*job.GetJobExecutionDetails().DurationSeconds.Get())
+	} else {
+		data.DurationSeconds = types.Int64Null()
+	}
+
+	restoredResources := newRestoredResourceModels(job)
+	data.RestoredVolumeId = types.StringNull()
+	data.RestoredInstanceId = types.StringNull()
+	data.RestoredDiskId = types.StringNull()
+	for _, restored := range restoredResources {
+		switch restored.ResourceType.ValueString() {
+		case restoredResourceTypeEbsVolume:
+			data.RestoredVolumeId = restored.ProviderResourceId
+		case restoredResourceTypeEc2Instance:
+			data.RestoredInstanceId = restored.ProviderResourceId
+		case restoredResourceTypeAzureDisk:
+			data.RestoredDiskId = restored.ProviderResourceId
+		}
+	}
+
+	restoredList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: restoredResourceAttrTypes}, restoredResources)
+	data.RestoredResources = restoredList
+	return diags
+}
+
+const (
+	restoredResourceTypeEc2Instance = "AWS_EC2_INSTANCE"
+	restoredResourceTypeEbsVolume   = "AWS_EBS_VOLUME"
+	restoredResourceTypeAzureDisk   = "AZURE_DISK"
+)
+
+// newRestoredResourceModels lists the resources a restore job reports in its result details
+func newRestoredResourceModels(job *externalEonSdkAPI.RestoreJob) []RestoredResourceModel {
+	restoredResources := []RestoredResourceModel{}
+
+	destination := job.GetDestinationDetails()
+	result, ok := destination.GetRestoreResultOk()
+	if !ok || result == nil {
+		return restoredResources
+	}
+
+	newModel := func(resourceType, providerResourceId string) RestoredResourceModel {
+		return RestoredResourceModel{
+			ResourceType:       types.StringValue(resourceType),
+			ProviderResourceId: types.StringValue(providerResourceId),
+			CloudProvider:      types.StringValue(string(destination.GetCloudProvider())),
+			ProviderAccountId:  types.StringValue(destination.GetProviderAccountId()),
+			Region:             types.StringValue(destination.GetRegion()),
+		}
+	}
+
+	if instance, ok := result.GetAwsEc2InstanceOk(); ok && instance != nil {
+		restoredResources = append(restoredResources, newModel(restoredResourceTypeEc2Instance, instance.GetInstanceId()))
+	}
+	if volume, ok := result.GetAwsEbsVolumeOk(); ok && volume != nil {
+		restoredResources = append(restoredResources, newModel(restoredResourceTypeEbsVolume, volume.GetVolumeId()))
+	}
+	if disk, ok := result.GetAzureDiskOk(); ok && disk != nil {
+		restoredResources = append(restoredResources, newModel(restoredResourceTypeAzureDisk, disk.GetDiskId()))
+	}
+
+	return restoredResources
+}
+
+func (r *RestoreJobResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RestoreJobResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.JobId.IsNull() || data.JobId.ValueString() == "" {
+		return
+	}
+
+	readTimeout, timeoutDiags := data.Timeouts.Read(ctx, restoreJobWaitTimeout(data))
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.refreshRestoreJob(ctx, &data, readTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+// refreshRestoreJob records the job's current status, and resumes waiting for jobs that were still running when
+// a previous apply stopped waiting
+func (r *RestoreJobResource) refreshRestoreJob(ctx context.Context, data *RestoreJobResourceModel, timeout time.Duration) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	job, err := r.client.GetRestoreJob(ctx, data.JobId.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read restore job: %s", err))
+		return diags
+	}
+
+	diags.Append(r.updateJobStatus(ctx, data, job)...)
+
+	if data.WaitForCompletion.ValueBool() && !isTerminalJobStatus(job.GetJobExecutionDetails().Status) {
+		tflog.Info(ctx, "Resuming wait for restore job", map[string]interface{}{
+			"job_id": data.JobId.ValueString(),
+			"status": data.Status.ValueString(),
+		})
+		// Refreshes only warn about failed restores, since an error would keep every later plan from recording
+		// the failure
+		diags.Append(r.waitForRestoreJob(ctx, data, timeout, diag.SeverityWarning)...)
+	}
+
+	return diags
+}
+
+func (r *RestoreJobResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RestoreJobResourceModel
+	var state RestoreJobResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	keepRestoreJobResult(&plan, state)
+
+	// Configuration now supplies the inputs that import couldn't read
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedRestoreJobKey, nil)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// keepRestoreJobResult copies the job details from state into the plan. Every restore input requires
+// replacement, so an update only changes how the provider waits for the job.
+func keepRestoreJobResult(plan *RestoreJobResourceModel, state RestoreJobResourceModel) {
+	plan.Id = state.Id
+	plan.ResourceId = state.ResourceId
+	plan.JobId = state.JobId
+	plan.Status = state.Status
+	plan.StatusMessage = state.StatusMessage
+	plan.CreatedAt = state.CreatedAt
+	plan.StartedAt = state.StartedAt
+	plan.CompletedAt = state.CompletedAt
+	plan.DurationSeconds = state.DurationSeconds
+	plan.SnapshotId = state.SnapshotId
+	plan.RecoveryPointTime = state.RecoveryPointTime
+	plan.RestoredResources = state.RestoredResources
+	plan.RestoredVolumeId = state.RestoredVolumeId
+	plan.RestoredInstanceId = state.RestoredInstanceId
+	plan.RestoredDiskId = state.RestoredDiskId
+}
+
 func (r *RestoreJobResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var data RestoreJobResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	deleteTimeout, timeoutDiags := data.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.warnRunningRestoreJob(ctx, data)...)
+}
+
+// warnRunningRestoreJob warns when destroy leaves a restore job running, because the Eon API has no endpoint for
+// cancelling restore jobs
+func (r *RestoreJobResource) warnRunningRestoreJob(ctx context.Context, data RestoreJobResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !data.JobId.IsNull() && data.JobId.ValueString() != "" {
+		job, err := r.client.GetRestoreJob(ctx, data.JobId.ValueString())
+		if err != nil {
+			tflog.Warn(ctx, "Unable to check restore job status before removing it from state", map[string]interface{}{
+				"job_id": data.JobId.ValueString(),
+				"error":  err.Error(),
+			})
+		} else if status := job.GetJobExecutionDetails().Status; !isTerminalJobStatus(status) {
+			diags.AddWarning(
+				"Restore Job Still Running",
+				fmt.Sprintf("Restore job %s is still in status %s. Removing it from Terraform state doesn't stop it, because the Eon API doesn't support cancelling restore jobs. Cancel it from the Eon console if it's no longer needed.", data.JobId.ValueString(), status),
+			)
+		}
+	}
+
 	tflog.Debug(ctx, "Restore job removed from state", map[string]interface{}{"job_id": data.JobId.ValueString()})
+	return diags
+}
+
+// isTerminalJobStatus reports whether a job has finished and will no longer change status
+func isTerminalJobStatus(status externalEonSdkAPI.JobStatus) bool {
+	switch status {
+	case externalEonSdkAPI.JOB_COMPLETED, externalEonSdkAPI.JOB_PARTIAL, externalEonSdkAPI.JOB_FAILED, externalEonSdkAPI.JOB_CANCELLED:
+		return true
+	default:
+		return false
+	}
+}
+
+// ModifyPlan validates new restore jobs against the snapshot, inventory resource and restore account they use,
+// so configuration problems fail at plan time instead of when the restore job starts
+func (r *RestoreJobResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only restores that are about to start are checked. Existing restores have already run, so in-place updates
+	// shouldn't fail because their snapshot has since expired or their restore account was disconnected, and
+	// destroys have nothing to check. Terraform plans replacements again with a null prior state, so restores that
+	// are being replaced are still checked.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var data RestoreJobResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validateRestorePlan(ctx, data, req.Config, &resp.Plan, restoreJobLayout)...)
+}
+
+// validateRestorePlan checks a planned restore against the snapshot, inventory resource and restore account it
+// uses. When resource_id isn't configured, it's read from the snapshot and set in the plan.
+func (r *RestoreJobResource) validateRestorePlan(ctx context.Context, data RestoreJobResourceModel, config tfsdk.Config, plan *tfsdk.Plan, layout restoreLayout) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(validateRestoreRegions(data, layout.configPath)...)
+
+	if r.client == nil {
+		return diags
+	}
+
+	var snapshot *externalEonSdkAPI.Snapshot
+	if isKnownString(data.SnapshotId) {
+		var err error
+		snapshot, err = r.client.GetSnapshot(ctx, data.SnapshotId.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("snapshot_id"), "Client Error", fmt.Sprintf("Unable to retrieve snapshot with ID %s: %s", data.SnapshotId.ValueString(), err))
+			return diags
+		}
+
+		var configResourceId types.String
+		diags.Append(config.GetAttribute(ctx, path.Root("resource_id"), &configResourceId)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		// Show the restored resource in the plan rather than "known after apply"
+		resourceId, resourceIdDiags := plannedResourceId(configResourceId, snapshot)
+		diags.Append(resourceIdDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.ResourceId = resourceId
+		diags.Append(plan.SetAttribute(ctx, path.Root("resource_id"), data.ResourceId)...)
+	}
+
+	if isKnownString(data.ResourceId) {
+		inventoryResource, err := r.client.GetResourceById(ctx, data.ResourceId.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("resource_id"), "Client Error", fmt.Sprintf("Unable to retrieve resource with ID %s: %s", data.ResourceId.ValueString(), err))
+			return diags
+		}
+
+		diags.Append(layout.validateConfig(data, inventoryResource.GetResourceType())...)
+
+		if isKnownString(data.RestoreAccountId) {
+			if err := r.validateRestoreAccount(ctx, data.RestoreAccountId.ValueString(), inventoryResource.GetCloudProvider()); err != nil {
+				diags.AddAttributeError(path.Root("restore_account_id"), "Invalid Restore Account", err.Error())
+			}
+		}
+	}
+
+	if snapshot == nil {
+		return diags
+	}
+
+	if data.EbsConfig != nil {
+		if detail := undersizedVolumeError(snapshot, data.EbsConfig.ProviderVolumeId.ValueString(), data.EbsConfig.VolumeSize); detail != "" {
+			diags.AddAttributeError(layout.configPath("ebs_config").AtName("volume_size"), "Invalid Volume Size", detail)
+		}
+	}
+
+	if data.Ec2Config != nil && !data.Ec2Config.VolumeRestoreParams.IsNull() && !data.Ec2Config.VolumeRestoreParams.IsUnknown() {
+		var volumeParams []VolumeRestoreParam
+		diags.Append(data.Ec2Config.VolumeRestoreParams.ElementsAs(ctx, &volumeParams, false)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		for i, volumeParam := range volumeParams {
+			if detail := undersizedVolumeError(snapshot, volumeParam.ProviderVolumeId.ValueString(), volumeParam.VolumeSize); detail != "" {
+				diags.AddAttributeError(layout.configPath("ec2_config").AtName("volume_restore_params").AtListIndex(i).AtName("volume_size"), "Invalid Volume Size", detail)
+			}
+		}
+	}
+
+	return diags
+}
+
+// plannedResourceId returns the resource_id to plan for a restore from snapshot. A configured resource_id is kept
+// and must be the snapshot's resource; otherwise it's read from the snapshot.
+func plannedResourceId(configResourceId types.String, snapshot *externalEonSdkAPI.Snapshot) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	snapshotResourceId := snapshot.GetResourceId()
+	if configResourceId.IsNull() {
+		return types.StringValue(snapshotResourceId), diags
+	}
+
+	if isKnownString(configResourceId) && snapshotResourceId != "" && configResourceId.ValueString() != snapshotResourceId {
+		diags.AddAttributeError(
+			path.Root("resource_id"),
+			"Configuration Error",
+			fmt.Sprintf("Snapshot %s is a snapshot of resource %s, not resource_id %s. Set resource_id to %s, or remove it to read it from the snapshot.",
+				snapshot.Id, snapshotResourceId, configResourceId.ValueString(), snapshotResourceId),
+		)
+	}
+	return configResourceId, diags
+}
+
+// isKnownString reports whether a string is set and known at plan time
+func isKnownString(value types.String) bool {
+	return !value.IsNull() && !value.IsUnknown() && value.ValueString() != ""
+}
+
+// restoreLayout describes where a restore resource keeps its restore configuration, so eon_restore_job and the
+// type-specific restore resources can share validation and job handling
+type restoreLayout struct {
+	// configPath returns the path of the attributes of a restore configuration block
+	configPath func(block string) path.Path
+	// validateConfig checks the restore configuration against the type of the resource being restored
+	validateConfig func(data RestoreJobResourceModel, resourceType externalEonSdkAPI.ResourceType) diag.Diagnostics
+}
+
+// restoreJobLayout nests each restore configuration in its own block
+var restoreJobLayout = restoreLayout{
+	configPath:     path.Root,
+	validateConfig: validateRestoreConfig,
+}
+
+// restoreConfigBlocks lists the restore configuration blocks in the order they're documented
+var restoreConfigBlocks = []string{
+	"ebs_config",
+	"ec2_config",
+	"rds_config",
+	"s3_bucket_config",
+	"s3_file_config",
+	"dynamodb_config",
+	"azure_blob_config",
+	"azure_blob_file_config",
+}
+
+// restoreConfigBlocksByType maps each restorable resource type to its configuration block per restore_type.
+// Resource types without a partial block only support full restores.
+var restoreConfigBlocksByType = map[externalEonSdkAPI.ResourceType]map[string]string{
+	externalEonSdkAPI.AWS_EC2:               {"full": "ec2_config", "partial": "ebs_config"},
+	externalEonSdkAPI.AWS_RDS:               {"full": "rds_config"},
+	externalEonSdkAPI.AWS_S3:                {"full": "s3_bucket_config", "partial": "s3_file_config"},
+	externalEonSdkAPI.AWS_DYNAMO_DB:         {"full": "dynamodb_config"},
+	externalEonSdkAPI.AZURE_STORAGE_ACCOUNT: {"full": "azure_blob_config", "partial": "azure_blob_file_config"},
+}
+
+// setRestoreConfigBlocks returns the restore configuration blocks that are set
+func setRestoreConfigBlocks(data RestoreJobResourceModel) map[string]bool {
+	return map[string]bool{
+		"ebs_config":             data.EbsConfig != nil,
+		"ec2_config":             data.Ec2Config != nil,
+		"rds_config":             data.RdsConfig != nil,
+		"s3_bucket_config":       data.S3BucketConfig != nil,
+		"s3_file_config":         data.S3FileConfig != nil,
+		"dynamodb_config":        data.DynamoDbConfig != nil,
+		"azure_blob_config":      data.AzureBlobConfig != nil,
+		"azure_blob_file_config": data.AzureBlobFileConfig != nil,
+	}
+}
+
+// ConfigValidators requires exactly one restore configuration block, and one that matches restore_type
+func (r *RestoreJobResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	blocks := make([]path.Expression, 0, len(restoreConfigBlocks))
+	for _, block := range restoreConfigBlocks {
+		blocks = append(blocks, path.MatchRoot(block))
+	}
+
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(blocks...),
+		restoreTypeConfigValidator{},
+		rdsRestoreConfigValidator{},
+	}
+}
+
+// restoreConfigBlocksForRestoreType returns the configuration blocks that can be used with a restore_type
+func restoreConfigBlocksForRestoreType(restoreType string) []string {
+	var blocks []string
+	for _, block := range restoreConfigBlocks {
+		for _, blocksByRestoreType := range restoreConfigBlocksByType {
+			if blocksByRestoreType[restoreType] == block {
+				blocks = append(blocks, block)
+				break
+			}
+		}
+	}
+	return blocks
+}
+
+// restoreTypeConfigValidator rejects configuration blocks that can't be used with the configured restore_type
+type restoreTypeConfigValidator struct{}
+
+var _ resource.ConfigValidator = restoreTypeConfigValidator{}
+
+func (v restoreTypeConfigValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v restoreTypeConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("restore_type full can only be used with %s, and partial with %s",
+		strings.Join(restoreConfigBlocksForRestoreType("full"), ", "), strings.Join(restoreConfigBlocksForRestoreType("partial"), ", "))
+}
+
+func (v restoreTypeConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var restoreType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("restore_type"), &restoreType)...)
+	if resp.Diagnostics.HasError() || restoreType.IsNull() || restoreType.IsUnknown() {
+		return
+	}
+
+	allowed := restoreConfigBlocksForRestoreType(restoreType.ValueString())
+	if len(allowed) == 0 {
+		// Invalid restore_type values are reported by the attribute validator
+		return
+	}
+
+	for _, block := range restoreConfigBlocks {
+		if slices.Contains(allowed, block) {
+			continue
+		}
+
+		var value types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(block), &value)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !value.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(block),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("%s can't be used with restore_type '%s'. Use one of: %s", block, restoreType.ValueString(), strings.Join(allowed, ", ")),
+			)
+		}
+	}
+}
+
+// rdsRestoreConfigValidator rejects the rds_config attributes the Eon API can't accept, so they fail validation
+// instead of the apply
+type rdsRestoreConfigValidator struct{}
+
+var _ resource.ConfigValidator = rdsRestoreConfigValidator{}
+
+func (v rdsRestoreConfigValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v rdsRestoreConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return "rds_config can't set attributes the Eon RDS restore API doesn't support"
+}
+
+func (v rdsRestoreConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config *RdsRestoreConfig
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("rds_config"), &config)...)
+	if resp.Diagnostics.HasError() || config == nil {
+		return
+	}
+
+	for _, name := range unsupportedRdsRestoreAttributes(config) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("rds_config").AtName(name),
+			"Unsupported Attribute",
+			fmt.Sprintf("rds_config.%s isn't supported by the Eon RDS restore API. Remove it from the configuration.", name),
+		)
+	}
+}
+
+// validateRestoreConfig checks that restore_type and the configuration blocks match the type of the resource
+// being restored
+func validateRestoreConfig(data RestoreJobResourceModel, resourceType externalEonSdkAPI.ResourceType) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch resourceType {
+	case externalEonSdkAPI.GCP_COMPUTE_ENGINE_INSTANCE, externalEonSdkAPI.GCP_CLOUD_SQL_INSTANCE, externalEonSdkAPI.GCP_CLOUD_STORAGE_BUCKET:
+		// The Eon API doesn't expose restore destinations for GCP resources yet
+		diags.AddAttributeError(path.Root("resource_id"), "Configuration Error", fmt.Sprintf("Restoring %s resources isn't supported by the Eon API yet. Restore GCP resources from the Eon console.", resourceType))
+		return diags
+	}
+
+	blocksByRestoreType, ok := restoreConfigBlocksByType[resourceType]
+	if !ok {
+		diags.AddAttributeError(path.Root("resource_id"), "Configuration Error", fmt.Sprintf("Unsupported resource type: %s. Supported types: AWS_EC2, AWS_RDS, AWS_S3, AWS_DYNAMO_DB, AZURE_STORAGE_ACCOUNT.", resourceType))
+		return diags
+	}
+
+	if data.RestoreType.IsUnknown() {
+		return diags
+	}
+	restoreType := data.RestoreType.ValueString()
+	if restoreType != "full" && restoreType != "partial" {
+		diags.AddAttributeError(path.Root("restore_type"), "Configuration Error", fmt.Sprintf("Invalid restore_type: %s. Supported types: full, partial", restoreType))
+		return diags
+	}
+
+	block, ok := blocksByRestoreType[restoreType]
+	if !ok {
+		diags.AddAttributeError(path.Root("restore_type"), "Configuration Error", fmt.Sprintf("%s resources only support restore_type 'full'", resourceType))
+		return diags
+	}
+
+	setBlocks := setRestoreConfigBlocks(data)
+	if !setBlocks[block] {
+		diags.AddAttributeError(path.Root(block), "Configuration Error", fmt.Sprintf("%s is required when restoring %s resources with restore_type '%s'", block, resourceType, restoreType))
+	}
+	for _, other := range restoreConfigBlocks {
+		if other != block && setBlocks[other] {
+			diags.AddAttributeError(path.Root(other), "Configuration Error", fmt.Sprintf("%s can't be used when restoring %s resources with restore_type '%s'. Use %s instead", other, resourceType, restoreType, block))
+		}
+	}
+
+	return diags
+}
+
+var (
+	awsRegionPattern           = regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]*)?-[a-z]+-\d+$`)
+	awsAvailabilityZonePattern = regexp.MustCompile(`^[a-z]{2}(-gov|-iso[a-z]*)?-[a-z]+-\d+(-[a-z]+-\d+)?[a-z]$`)
+)
+
+// validateRestoreRegions checks that the AWS regions and availability zones restored to are well formed, so an
+// Azure region or a zone given as a region fails at plan time
+func validateRestoreRegions(data RestoreJobResourceModel, configPath func(block string) path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	checkRegion := func(attributePath path.Path, region types.String) {
+		if isKnownString(region) && !awsRegionPattern.MatchString(region.ValueString()) {
+			diags.AddAttributeError(attributePath, "Invalid Region", fmt.Sprintf("%q isn't an AWS region, such as us-east-1.", region.ValueString()))
+		}
+	}
+
+	if data.Ec2Config != nil {
+		checkRegion(configPath("ec2_config").AtName("region"), data.Ec2Config.Region)
+	}
+	if data.RdsConfig != nil {
+		checkRegion(configPath("rds_config").AtName("region"), data.RdsConfig.Region)
+	}
+	if data.DynamoDbConfig != nil {
+		checkRegion(configPath("dynamodb_config").AtName("region"), data.DynamoDbConfig.Region)
+	}
+	if data.EbsConfig != nil && isKnownString(data.EbsConfig.AvailabilityZone) && !awsAvailabilityZonePattern.MatchString(data.EbsConfig.AvailabilityZone.ValueString()) {
+		diags.AddAttributeError(configPath("ebs_config").AtName("availability_zone"), "Invalid Availability Zone", fmt.Sprintf("%q isn't an AWS availability zone, such as us-east-1a.", data.EbsConfig.AvailabilityZone.ValueString()))
+	}
+
+	return diags
+}
+
+// UpgradeState converts sizes stored as numbers by schema version 0 to sizes with units
+func (r *RestoreJobResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil || req.RawState.JSON == nil {
+					resp.Diagnostics.AddError("Unable to Upgrade State", "The prior restore job state is missing.")
+					return
+				}
+
+				upgraded, err := upgradeRestoreJobStateV0(req.RawState.JSON)
+				if err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("Unable to upgrade restore job state from schema version 0: %s", err))
+					return
+				}
+
+				resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+			},
+		},
+	}
+}
+
+// upgradeRestoreJobStateV0 rewrites version 0 restore job state JSON for version 1. ebs_config.volume_size was in
+// bytes, and ec2_config.volume_restore_params[].volume_size and rds_config.allocated_storage were in GiB.
+func upgradeRestoreJobStateV0(rawState []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(rawState))
+	decoder.UseNumber()
+
+	var state map[string]interface{}
+	if err := decoder.Decode(&state); err != nil {
+		return nil, err
+	}
+
+	if ebsConfig, ok := state["ebs_config"].(map[string]interface{}); ok {
+		if err := upgradeSizeV0(ebsConfig, "volume_size", 1); err != nil {
+			return nil, err
+		}
+	}
+
+	if ec2Config, ok := state["ec2_config"].(map[string]interface{}); ok {
+		if volumeParams, ok := ec2Config["volume_restore_params"].([]interface{}); ok {
+			for _, volumeParam := range volumeParams {
+				if volumeParam, ok := volumeParam.(map[string]interface{}); ok {
+					if err := upgradeSizeV0(volumeParam, "volume_size", sizeUnits["gib"]); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	if rdsConfig, ok := state["rds_config"].(map[string]interface{}); ok {
+		if err := upgradeSizeV0(rdsConfig, "allocated_storage", sizeUnits["gib"]); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(state)
+}
+
+// upgradeSizeV0 replaces a numeric size in units of multiplier bytes with a size string
+func upgradeSizeV0(object map[string]interface{}, key string, multiplier int64) error {
+	number, ok := object[key].(json.Number)
+	if !ok {
+		return nil
+	}
+
+	value, err := number.Int64()
+	if err != nil {
+		return fmt.Errorf("invalid %s %q: %w", key, number, err)
+	}
+	object[key] = formatSize(value * multiplier)
+	return nil
 }
 
+// importedRestoreJobKey is the private state key that marks restore jobs whose inputs were rebuilt on import. The
+// Eon API doesn't report most target settings, so the first plan after import adopts the configured values
+// instead of replacing the job.
+const importedRestoreJobKey = "imported_restore_job"
+
+// ImportState rebuilds the restore inputs the Eon API reports for the job: the snapshot, resource, restore account,
+// restore type, configuration block and target region
 func (r *RestoreJobResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	job, err := r.client.GetRestoreJob(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore job %s: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.setImportedRestoreJobState(ctx, &resp.State, req.ID, job)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedRestoreJobKey, []byte("true"))...)
+}
+
+// setImportedRestoreJobState sets state from the job's details, with the configuration block the job uses
+func (r *RestoreJobResource) setImportedRestoreJobState(ctx context.Context, state *tfsdk.State, jobId string, job *externalEonSdkAPI.RestoreJob) diag.Diagnostics {
+	data, block, configValues, diags := r.newImportedRestoreJobModel(ctx, jobId, job)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(state.Set(ctx, &data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	blockType, typeDiags := state.Schema.TypeAtPath(ctx, path.Root(block))
+	diags.Append(typeDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	config, configDiags := newRestoreConfigObject(ctx, blockType.(types.ObjectType), configValues)
+	diags.Append(configDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root(block), config)...)
+	return diags
+}
+
+// importedRestoreConfigBlocks maps the restore job types eon_restore_job can manage to their restore_type and
+// configuration block
+var importedRestoreConfigBlocks = map[externalEonSdkAPI.RestoreJobType][2]string{
+	externalEonSdkAPI.AWS_EC2_EBS_VOLUME_RESTORE:         {"partial", "ebs_config"},
+	externalEonSdkAPI.AWS_EC2_INSTANCE_RESTORE:           {"full", "ec2_config"},
+	externalEonSdkAPI.AWS_RDS_INSTANCE_RESTORE:           {"full", "rds_config"},
+	externalEonSdkAPI.AWS_S3_BUCKET_RESTORE:              {"full", "s3_bucket_config"},
+	externalEonSdkAPI.AWS_S3_OBJECT_RESTORE:              {"partial", "s3_file_config"},
+	externalEonSdkAPI.AWS_DYNAMO_DB_TABLE_RESTORE:        {"full", "dynamodb_config"},
+	externalEonSdkAPI.AZURE_STORAGE_ACCOUNT_RESTORE:      {"full", "azure_blob_config"},
+	externalEonSdkAPI.AZURE_STORAGE_ACCOUNT_BLOB_RESTORE: {"partial", "azure_blob_file_config"},
+}
+
+// newImportedRestoreJobModel builds restore job state from the job's details. It returns the configuration block
+// the job uses, and the block's attributes that the job details include.
+func (r *RestoreJobResource) newImportedRestoreJobModel(ctx context.Context, jobId string, job *externalEonSdkAPI.RestoreJob) (RestoreJobResourceModel, string, map[string]attr.Value, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	restoreTypeAndBlock, ok := importedRestoreConfigBlocks[job.GetRestoreType()]
+	if !ok {
+		diags.AddError("Unsupported Restore Job", fmt.Sprintf("Restore job %s is a %s job, which eon_restore_job can't manage.", jobId, job.GetRestoreType()))
+		return RestoreJobResourceModel{}, "", nil, diags
+	}
+	restoreType, block := restoreTypeAndBlock[0], restoreTypeAndBlock[1]
+
+	destination := job.GetDestinationDetails()
+	data := RestoreJobResourceModel{
+		Id:                types.StringValue(jobId),
+		JobId:             types.StringValue(jobId),
+		RestoreType:       types.StringValue(restoreType),
+		SnapshotId:        types.StringNull(),
+		ResourceId:        types.StringNull(),
+		PointInTime:       types.StringNull(),
+		RestoreAccountId:  types.StringValue(destination.GetRestoreAccountId()),
+		Triggers:          types.MapNull(types.StringType),
+		TimeoutMinutes:    types.Int64Value(60),
+		WaitForCompletion: types.BoolValue(true),
+		Timeouts: timeouts.Value{Object: types.ObjectNull(map[string]attr.Type{
+			"create": types.StringType,
+			"read":   types.StringType,
+			"update": types.StringType,
+			"delete": types.StringType,
+		})},
+		RecoveryPointTime: types.StringNull(),
+	}
+
+	if snapshot, ok := job.GetSnapshotDetailsOk(); ok && snapshot != nil {
+		if snapshot.Id != nil {
+			data.SnapshotId = types.StringValue(*snapshot.Id)
+		}
+		if !snapshot.PointInTime.IsZero() {
+			data.RecoveryPointTime = types.StringValue(snapshot.PointInTime.Format(time.RFC3339))
+		}
+	}
+	if resourceDetails, ok := job.GetResourceDetailsOk(); ok && resourceDetails != nil {
+		data.ResourceId = types.StringValue(resourceDetails.Id)
+	}
+
+	diags.Append(r.updateJobStatus(ctx, &data, job)...)
+
+	configValues := map[string]attr.Value{}
+	if region := destination.GetRegion(); region != "" {
+		switch block {
+		case "ec2_config", "rds_config", "dynamodb_config":
+			configValues["region"] = types.StringValue(region)
+		}
+	}
+
+	return data, block, configValues, diags
+}
+
+// newRestoreConfigObject returns a configuration block value with the given attributes set and the rest null
+func newRestoreConfigObject(ctx context.Context, objectType types.ObjectType, values map[string]attr.Value) (types.Object, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	attributes := make(map[string]attr.Value, len(objectType.AttrTypes))
+	for name, attributeType := range objectType.AttrTypes {
+		if value, ok := values[name]; ok {
+			attributes[name] = value
+			continue
+		}
+
+		null, err := attributeType.ValueFromTerraform(ctx, tftypes.NewValue(attributeType.TerraformType(ctx), nil))
+		if err != nil {
+			diags.AddError("Unable to Import Restore Job", fmt.Sprintf("Unable to create a null value for %s: %s", name, err))
+			return types.ObjectNull(objectType.AttrTypes), diags
+		}
+		attributes[name] = null
+	}
+
+	object, objectDiags := types.ObjectValue(objectType.AttrTypes, attributes)
+	diags.Append(objectDiags...)
+	return object, diags
+}
+
+// privateState reads resource private state
+type privateState interface {
+	GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics)
+}
+
+// isImportedRestoreJob reports whether the restore job's inputs were rebuilt on import and haven't been
+// completed from configuration yet
+func isImportedRestoreJob(ctx context.Context, private privateState) (bool, diag.Diagnostics) {
+	if private == nil {
+		return false, nil
+	}
+	value, diags := private.GetKey(ctx, importedRestoreJobKey)
+	return len(value) > 0, diags
+}
+
+const restoreRequiresReplaceDescription = "If the value of this attribute changes, Terraform will destroy and recreate the resource, unless the restore job was imported and the value wasn't known."
+
+// restoreInputChanged reports whether a changed restore input requires a new restore job. Inputs that import
+// couldn't read from the Eon API are null in state, so configuration can set them without starting a new job.
+func restoreInputChanged(ctx context.Context, private privateState, stateValue attr.Value) (bool, diag.Diagnostics) {
+	imported, diags := isImportedRestoreJob(ctx, private)
+	return !imported || !stateValue.IsNull(), diags
+}
+
+// restoreInputRequiresReplace requires replacement when a restore input changes, except when configuration sets an
+// input that import couldn't read from the Eon API
+func restoreInputRequiresReplace() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace, resp.Diagnostics = restoreInputChanged(ctx, req.Private, req.StateValue)
+		},
+		restoreRequiresReplaceDescription,
+		restoreRequiresReplaceDescription,
+	)
+}
+
+// restoreInt64InputRequiresReplace is restoreInputRequiresReplace for number inputs
+func restoreInt64InputRequiresReplace() planmodifier.Int64 {
+	return int64planmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace, resp.Diagnostics = restoreInputChanged(ctx, req.Private, req.StateValue)
+		},
+		restoreRequiresReplaceDescription,
+		restoreRequiresReplaceDescription,
+	)
+}
+
+// restoreBoolInputRequiresReplace is restoreInputRequiresReplace for boolean inputs
+func restoreBoolInputRequiresReplace() planmodifier.Bool {
+	return boolplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.BoolRequest, resp *boolplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace, resp.Diagnostics = restoreInputChanged(ctx, req.Private, req.StateValue)
+		},
+		restoreRequiresReplaceDescription,
+		restoreRequiresReplaceDescription,
+	)
+}
+
+// restoreListInputRequiresReplace is restoreInputRequiresReplace for list inputs and blocks
+func restoreListInputRequiresReplace() planmodifier.List {
+	return listplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ListRequest, resp *listplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace, resp.Diagnostics = restoreInputChanged(ctx, req.Private, req.StateValue)
+		},
+		restoreRequiresReplaceDescription,
+		restoreRequiresReplaceDescription,
+	)
+}
+
+// restoreMapInputRequiresReplace is restoreInputRequiresReplace for map inputs, such as triggers
+func restoreMapInputRequiresReplace() planmodifier.Map {
+	return mapplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.MapRequest, resp *mapplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace, resp.Diagnostics = restoreInputChanged(ctx, req.Private, req.StateValue)
+		},
+		restoreRequiresReplaceDescription,
+		restoreRequiresReplaceDescription,
+	)
+}
+
+// restoreConfigRequiresReplace requires replacement when a configuration block changes. For an imported restore
+// job, only the attributes import read from the Eon API have to match.
+func restoreConfigRequiresReplace() planmodifier.Object {
+	return objectplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.ObjectRequest, resp *objectplanmodifier.RequiresReplaceIfFuncResponse) {
+			imported, diags := isImportedRestoreJob(ctx, req.Private)
+			resp.Diagnostics.Append(diags...)
+			resp.RequiresReplace = restoreConfigChanged(imported, req.StateValue, req.PlanValue)
+		},
+		restoreRequiresReplaceDescription,
+		restoreRequiresReplaceDescription,
+	)
+}
+
+// restoreConfigChanged reports whether a configuration block change requires a new restore job
+func restoreConfigChanged(imported bool, state, plan types.Object) bool {
+	if !imported || state.IsNull() || plan.IsNull() || plan.IsUnknown() {
+		return true
+	}
+
+	planAttributes := plan.Attributes()
+	for name, value := range state.Attributes() {
+		if !value.IsNull() && !value.Equal(planAttributes[name]) {
+			return true
+		}
+	}
+	return false
 }
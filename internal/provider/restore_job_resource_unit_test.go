@@ -0,0 +1,828 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestIsTerminalJobStatus tests which job statuses are treated as finished
+func TestIsTerminalJobStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		status   externalEonSdkAPI.JobStatus
+		expected bool
+	}{
+		{externalEonSdkAPI.JOB_UNSPECIFIED, false},
+		{externalEonSdkAPI.JOB_PENDING, false},
+		{externalEonSdkAPI.JOB_RUNNING, false},
+		{externalEonSdkAPI.JOB_COMPLETED, true},
+		{externalEonSdkAPI.JOB_PARTIAL, true},
+		{externalEonSdkAPI.JOB_FAILED, true},
+		{externalEonSdkAPI.JOB_CANCELLED, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.status), func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, isTerminalJobStatus(tt.status))
+		})
+	}
+}
+
+// TestNewRestoredResourceModels tests reading restored resource IDs from the job's result details
+func TestNewRestoredResourceModels(t *testing.T) {
+	t.Parallel()
+
+	newJob := func(result *externalEonSdkAPI.RestoreResult) *externalEonSdkAPI.RestoreJob {
+		destination := externalEonSdkAPI.NewDestinationDetails("restore-account", "123456789012", externalEonSdkAPI.AWS, "us-east-1")
+		if result != nil {
+			destination.SetRestoreResult(*result)
+		}
+		return &externalEonSdkAPI.RestoreJob{DestinationDetails: *destination}
+	}
+
+	t.Run("no result", func(t *testing.T) {
+		t.Parallel()
+		assert.Empty(t, newRestoredResourceModels(newJob(nil)))
+	})
+
+	t.Run("ec2 instance", func(t *testing.T) {
+		t.Parallel()
+		result := externalEonSdkAPI.NewRestoreResult()
+		result.SetAwsEc2Instance(*externalEonSdkAPI.NewAwsEc2InstanceRestoreResult("i-0123456789abcdef0"))
+
+		restored := newRestoredResourceModels(newJob(result))
+		if assert.Len(t, restored, 1) {
+			assert.Equal(t, restoredResourceTypeEc2Instance, restored[0].ResourceType.ValueString())
+			assert.Equal(t, "i-0123456789abcdef0", restored[0].ProviderResourceId.ValueString())
+			assert.Equal(t, "AWS", restored[0].CloudProvider.ValueString())
+			assert.Equal(t, "123456789012", restored[0].ProviderAccountId.ValueString())
+			assert.Equal(t, "us-east-1", restored[0].Region.ValueString())
+		}
+	})
+
+	t.Run("ebs volume", func(t *testing.T) {
+		t.Parallel()
+		result := externalEonSdkAPI.NewRestoreResult()
+		result.SetAwsEbsVolume(*externalEonSdkAPI.NewAwsEbsVolumeRestoreResult("vol-0123456789abcdef0"))
+
+		restored := newRestoredResourceModels(newJob(result))
+		if assert.Len(t, restored, 1) {
+			assert.Equal(t, restoredResourceTypeEbsVolume, restored[0].ResourceType.ValueString())
+			assert.Equal(t, "vol-0123456789abcdef0", restored[0].ProviderResourceId.ValueString())
+		}
+	})
+}
+
+// TestRestoreJobResourceSchemaRequiresReplace tests that every restore input forces a new restore job
+func TestRestoreJobResourceSchemaRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resp := &resource.SchemaResponse{}
+	NewRestoreJobResource().Schema(ctx, resource.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	updatable := map[string]bool{
+		"timeout_minutes":     true,
+		"wait_for_completion": true,
+		"timeouts":            true,
+	}
+
+	requiresReplace := func(descriptions []string) bool {
+		for _, description := range descriptions {
+			if strings.Contains(description, "destroy and recreate") {
+				return true
+			}
+		}
+		return false
+	}
+
+	for name, attribute := range resp.Schema.Attributes {
+		if updatable[name] || !(attribute.IsRequired() || attribute.IsOptional()) {
+			continue
+		}
+
+		var descriptions []string
+		switch a := attribute.(type) {
+		case schema.StringAttribute:
+			for _, modifier := range a.PlanModifiers {
+				descriptions = append(descriptions, modifier.Description(ctx))
+			}
+		case schema.MapAttribute:
+			for _, modifier := range a.PlanModifiers {
+				descriptions = append(descriptions, modifier.Description(ctx))
+			}
+		}
+		assert.True(t, requiresReplace(descriptions), "attribute %s should require replacement", name)
+	}
+
+	for name, block := range resp.Schema.Blocks {
+		if updatable[name] {
+			continue
+		}
+
+		var descriptions []string
+		if b, ok := block.(schema.SingleNestedBlock); ok {
+			for _, modifier := range b.PlanModifiers {
+				descriptions = append(descriptions, modifier.Description(ctx))
+			}
+		}
+		assert.True(t, requiresReplace(descriptions), "block %s should require replacement", name)
+	}
+}
+
+// TestResolveRestoreSnapshotConfigurationErrors tests the snapshot_id and point_in_time combinations rejected before any API call
+func TestResolveRestoreSnapshotConfigurationErrors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		snapshotId  types.String
+		pointInTime types.String
+		resourceId  types.String
+		expected    string
+	}{
+		{
+			name:        "both set",
+			snapshotId:  types.StringValue("snapshot-1"),
+			pointInTime: types.StringValue("2024-01-02T15:04:05Z"),
+			resourceId:  types.StringValue("resource-1"),
+			expected:    "Only one of snapshot_id or point_in_time can be set",
+		},
+		{
+			name:        "neither set",
+			snapshotId:  types.StringUnknown(),
+			pointInTime: types.StringNull(),
+			resourceId:  types.StringUnknown(),
+			expected:    "Either snapshot_id or point_in_time must be set",
+		},
+		{
+			name:        "point in time without resource",
+			snapshotId:  types.StringUnknown(),
+			pointInTime: types.StringValue("2024-01-02T15:04:05Z"),
+			resourceId:  types.StringUnknown(),
+			expected:    "resource_id is required when point_in_time is set",
+		},
+		{
+			name:        "invalid point in time",
+			snapshotId:  types.StringUnknown(),
+			pointInTime: types.StringValue("2024-01-02"),
+			resourceId:  types.StringValue("resource-1"),
+			expected:    "Invalid point_in_time",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &RestoreJobResource{}
+			snapshot, diags := r.resolveRestoreSnapshot(context.Background(), RestoreJobResourceModel{
+				SnapshotId:  tt.snapshotId,
+				PointInTime: tt.pointInTime,
+				ResourceId:  tt.resourceId,
+			})
+
+			assert.Nil(t, snapshot)
+			require.True(t, diags.HasError())
+			assert.Contains(t, diags.Errors()[0].Detail(), tt.expected)
+		})
+	}
+}
+
+// TestNewStorageAccountRestoreTarget tests building the Azure storage account restore destination
+func TestNewStorageAccountRestoreTarget(t *testing.T) {
+	t.Parallel()
+
+	target := newStorageAccountRestoreTarget(types.StringValue("restoredata"), types.StringNull(), types.StringValue("backups"), types.StringValue("restored/"))
+	assert.Equal(t, "restoredata", target.Name)
+	assert.Equal(t, "backups", target.Container)
+	assert.Nil(t, target.ResourceGroup)
+	if assert.NotNil(t, target.Prefix) {
+		assert.Equal(t, "restored/", *target.Prefix)
+	}
+
+	target = newStorageAccountRestoreTarget(types.StringValue("restoredata"), types.StringValue("dr-rg"), types.StringValue("backups"), types.StringNull())
+	if assert.NotNil(t, target.ResourceGroup) {
+		assert.Equal(t, "dr-rg", *target.ResourceGroup)
+	}
+	assert.Nil(t, target.Prefix)
+}
+
+// TestNewDynamoDbRestoreTarget tests building the DynamoDB restore destination
+func TestNewDynamoDbRestoreTarget(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	target, err := newDynamoDbRestoreTarget(ctx, &DynamoDbRestoreConfig{
+		TableName:          types.StringValue("orders-restored"),
+		Region:             types.StringValue("us-west-2"),
+		KmsKeyId:           types.StringValue("arn:aws:kms:us-west-2:123456789012:key/abcd"),
+		WriteCapacityUnits: types.Int64Value(25),
+		Tags:               types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("dr")}),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "orders-restored", target.RestoredName)
+	assert.Equal(t, "us-west-2", target.RestoreRegion)
+	assert.Equal(t, "arn:aws:kms:us-west-2:123456789012:key/abcd", target.EncryptionKeyId)
+	if assert.NotNil(t, target.WriteCapacityUnits) {
+		assert.Equal(t, int32(25), *target.WriteCapacityUnits)
+	}
+	if assert.NotNil(t, target.Tags) {
+		assert.Equal(t, map[string]string{"env": "dr"}, *target.Tags)
+	}
+
+	target, err = newDynamoDbRestoreTarget(ctx, &DynamoDbRestoreConfig{
+		TableName:          types.StringValue("orders-restored"),
+		Region:             types.StringValue("us-west-2"),
+		KmsKeyId:           types.StringNull(),
+		WriteCapacityUnits: types.Int64Null(),
+		Tags:               types.MapNull(types.StringType),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, target.EncryptionKeyId)
+	assert.Nil(t, target.WriteCapacityUnits)
+	assert.Nil(t, target.Tags)
+
+	_, err = newDynamoDbRestoreTarget(ctx, &DynamoDbRestoreConfig{
+		WriteCapacityUnits: types.Int64Value(1 << 40),
+		Tags:               types.MapNull(types.StringType),
+	})
+	assert.ErrorContains(t, err, "invalid write_capacity_units")
+}
+
+// TestMissingSnapshotVolumeIds tests checking volume IDs against the volumes recorded in a snapshot
+func TestMissingSnapshotVolumeIds(t *testing.T) {
+	t.Parallel()
+
+	ec2Properties := externalEonSdkAPI.NewAwsEc2SnapshotProperties()
+	ec2Properties.SetVolumes([]externalEonSdkAPI.InventorySnapshotVolume{
+		{ProviderVolumeId: "vol-root"},
+		{ProviderVolumeId: "vol-data"},
+	})
+	properties := externalEonSdkAPI.NewResourceSnapshotProperties()
+	properties.SetAwsEc2(*ec2Properties)
+	resourceSnapshot := externalEonSdkAPI.NewResourceSnapshot()
+	resourceSnapshot.SetProperties(*properties)
+	snapshot := &externalEonSdkAPI.Snapshot{Id: "snapshot-1"}
+	snapshot.SetResource(*resourceSnapshot)
+
+	assert.Empty(t, missingSnapshotVolumeIds(snapshot, []string{"vol-root", "vol-data"}))
+	assert.Empty(t, missingSnapshotVolumeIds(snapshot, []string{"vol-root"}))
+	assert.Empty(t, missingSnapshotVolumeIds(snapshot, []string{""}))
+	assert.Equal(t, []string{"vol-other"}, missingSnapshotVolumeIds(snapshot, []string{"vol-root", "vol-other"}))
+
+	// Snapshots without volume details can't be checked
+	assert.Empty(t, missingSnapshotVolumeIds(&externalEonSdkAPI.Snapshot{Id: "snapshot-2"}, []string{"vol-other"}))
+}
+
+// TestUnsupportedRdsRestoreAttributes tests detecting rds_config settings the restore API can't accept
+func TestUnsupportedRdsRestoreAttributes(t *testing.T) {
+	t.Parallel()
+
+	config := &RdsRestoreConfig{
+		DbInstanceIdentifier:  types.StringValue("restored-db"),
+		Region:                types.StringValue("us-east-1"),
+		KmsKeyId:              types.StringValue("alias/aws/rds"),
+		AllocatedStorage:      NewSizeNull(),
+		StorageType:           types.StringNull(),
+		BackupRetentionPeriod: types.Int64Null(),
+		MultiAz:               types.BoolNull(),
+		PubliclyAccessible:    types.BoolNull(),
+		StorageEncrypted:      types.BoolNull(),
+	}
+	assert.Empty(t, unsupportedRdsRestoreAttributes(config))
+
+	config.MultiAz = types.BoolValue(false)
+	config.AllocatedStorage = NewSizeValue("20GiB")
+	assert.Equal(t, []string{"allocated_storage", "multi_az"}, unsupportedRdsRestoreAttributes(config))
+}
+
+// TestUpgradeRestoreJobStateV0 tests converting numeric sizes in version 0 state to sizes with units
+func TestUpgradeRestoreJobStateV0(t *testing.T) {
+	t.Parallel()
+
+	upgraded, err := upgradeRestoreJobStateV0([]byte(`{
+		"id": "job-1",
+		"ebs_config": {"provider_volume_id": "vol-1", "volume_size": 107374182400},
+		"ec2_config": {"volume_restore_params": [{"provider_volume_id": "vol-2", "volume_size": 1024}, {"provider_volume_id": "vol-3", "volume_size": null}]},
+		"rds_config": null
+	}`))
+	require.NoError(t, err)
+
+	var state map[string]interface{}
+	require.NoError(t, json.Unmarshal(upgraded, &state))
+	assert.Equal(t, "job-1", state["id"])
+	assert.Equal(t, "100GiB", state["ebs_config"].(map[string]interface{})["volume_size"])
+
+	volumeParams := state["ec2_config"].(map[string]interface{})["volume_restore_params"].([]interface{})
+	assert.Equal(t, "1TiB", volumeParams[0].(map[string]interface{})["volume_size"])
+	assert.Nil(t, volumeParams[1].(map[string]interface{})["volume_size"])
+	assert.Nil(t, state["rds_config"])
+}
+
+// TestUndersizedVolumeError tests comparing restored volume sizes with the snapshotted volumes
+func TestUndersizedVolumeError(t *testing.T) {
+	t.Parallel()
+
+	ec2Properties := externalEonSdkAPI.NewAwsEc2SnapshotProperties()
+	ec2Properties.SetVolumes([]externalEonSdkAPI.InventorySnapshotVolume{
+		{ProviderVolumeId: "vol-root", VolumeSettings: externalEonSdkAPI.VolumeSettings{SizeBytes: 100 << 30}},
+	})
+	properties := externalEonSdkAPI.NewResourceSnapshotProperties()
+	properties.SetAwsEc2(*ec2Properties)
+	resourceSnapshot := externalEonSdkAPI.NewResourceSnapshot()
+	resourceSnapshot.SetProperties(*properties)
+	snapshot := &externalEonSdkAPI.Snapshot{Id: "snapshot-1"}
+	snapshot.SetResource(*resourceSnapshot)
+
+	assert.Empty(t, undersizedVolumeError(snapshot, "vol-root", NewSizeValue("100GiB")))
+	assert.Empty(t, undersizedVolumeError(snapshot, "vol-root", NewSizeValue("1TiB")))
+	assert.Empty(t, undersizedVolumeError(snapshot, "vol-root", NewSizeNull()))
+	assert.Empty(t, undersizedVolumeError(snapshot, "vol-other", NewSizeValue("1GiB")))
+	assert.Contains(t, undersizedVolumeError(snapshot, "vol-root", NewSizeValue("50")), "volume_size 50GiB is smaller than the 100GiB source volume vol-root")
+}
+
+// TestValidateRestoreConfig tests matching restore_type and configuration blocks to the resource type
+func TestValidateRestoreConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		resourceType externalEonSdkAPI.ResourceType
+		data         RestoreJobResourceModel
+		expected     []string
+	}{
+		{
+			name:         "ec2 full",
+			resourceType: externalEonSdkAPI.AWS_EC2,
+			data:         RestoreJobResourceModel{RestoreType: types.StringValue("full"), Ec2Config: &Ec2RestoreConfig{}},
+		},
+		{
+			name:         "ec2 partial",
+			resourceType: externalEonSdkAPI.AWS_EC2,
+			data:         RestoreJobResourceModel{RestoreType: types.StringValue("partial"), EbsConfig: &EbsRestoreConfig{}},
+		},
+		{
+			name:         "missing block",
+			resourceType: externalEonSdkAPI.AWS_EC2,
+			data:         RestoreJobResourceModel{RestoreType: types.StringValue("full")},
+			expected:     []string{"ec2_config is required when restoring AWS_EC2 resources with restore_type 'full'"},
+		},
+		{
+			name:         "extra block",
+			resourceType: externalEonSdkAPI.AWS_S3,
+			data:         RestoreJobResourceModel{RestoreType: types.StringValue("full"), S3BucketConfig: &S3BucketRestoreConfig{}, S3FileConfig: &S3FileRestoreConfig{}},
+			expected:     []string{"s3_file_config can't be used when restoring AWS_S3 resources with restore_type 'full'. Use s3_bucket_config instead"},
+		},
+		{
+			name:         "partial rds",
+			resourceType: externalEonSdkAPI.AWS_RDS,
+			data:         RestoreJobResourceModel{RestoreType: types.StringValue("partial"), RdsConfig: &RdsRestoreConfig{}},
+			expected:     []string{"AWS_RDS resources only support restore_type 'full'"},
+		},
+		{
+			name:         "invalid restore type",
+			resourceType: externalEonSdkAPI.AWS_RDS,
+			data:         RestoreJobResourceModel{RestoreType: types.StringValue("incremental")},
+			expected:     []string{"Invalid restore_type: incremental. Supported types: full, partial"},
+		},
+		{
+			name:         "unknown restore type",
+			resourceType: externalEonSdkAPI.AWS_RDS,
+			data:         RestoreJobResourceModel{RestoreType: types.StringUnknown()},
+		},
+		{
+			name:         "gcp",
+			resourceType: externalEonSdkAPI.GCP_CLOUD_STORAGE_BUCKET,
+			data:         RestoreJobResourceModel{RestoreType: types.StringValue("full")},
+			expected:     []string{"Restoring GCP_CLOUD_STORAGE_BUCKET resources isn't supported by the Eon API yet. Restore GCP resources from the Eon console."},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var details []string
+			for _, d := range validateRestoreConfig(tt.data, tt.resourceType).Errors() {
+				details = append(details, d.Detail())
+			}
+			assert.Equal(t, tt.expected, details)
+		})
+	}
+}
+
+// TestValidateRestoreRegions tests rejecting regions and availability zones that aren't AWS ones
+func TestValidateRestoreRegions(t *testing.T) {
+	t.Parallel()
+
+	valid := RestoreJobResourceModel{
+		Ec2Config:      &Ec2RestoreConfig{Region: types.StringValue("us-gov-west-1")},
+		RdsConfig:      &RdsRestoreConfig{Region: types.StringValue("eu-central-1")},
+		DynamoDbConfig: &DynamoDbRestoreConfig{Region: types.StringUnknown()},
+		EbsConfig:      &EbsRestoreConfig{AvailabilityZone: types.StringValue("us-west-2-lax-1a")},
+	}
+	assert.False(t, validateRestoreRegions(valid, path.Root).HasError())
+
+	invalid := RestoreJobResourceModel{
+		Ec2Config: &Ec2RestoreConfig{Region: types.StringValue("eastus")},
+		EbsConfig: &EbsRestoreConfig{AvailabilityZone: types.StringValue("us-east-1")},
+	}
+	assert.Len(t, validateRestoreRegions(invalid, path.Root).Errors(), 2)
+}
+
+// TestRestoreTypeConfigValidator tests rejecting configuration blocks that don't match restore_type
+func TestRestoreTypeConfigValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewRestoreJobResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// newConfig returns a configuration with restore_type and one empty block set, and every other value null
+	newConfig := func(restoreType, block string) tfsdk.Config {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values["restore_type"] = tftypes.NewValue(tftypes.String, restoreType)
+
+		blockType := objectType.AttributeTypes[block].(tftypes.Object)
+		blockValues := make(map[string]tftypes.Value, len(blockType.AttributeTypes))
+		for name, attributeType := range blockType.AttributeTypes {
+			blockValues[name] = tftypes.NewValue(attributeType, nil)
+		}
+		values[block] = tftypes.NewValue(blockType, blockValues)
+
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	}
+
+	tests := []struct {
+		restoreType string
+		block       string
+		wantErr     bool
+	}{
+		{"full", "ec2_config", false},
+		{"full", "rds_config", false},
+		{"full", "s3_bucket_config", false},
+		{"full", "dynamodb_config", false},
+		{"full", "ebs_config", true},
+		{"full", "s3_file_config", true},
+		{"partial", "ebs_config", false},
+		{"partial", "s3_file_config", false},
+		{"partial", "azure_blob_file_config", false},
+		{"partial", "ec2_config", true},
+		{"partial", "rds_config", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.restoreType+" "+tt.block, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.ValidateConfigResponse{}
+			restoreTypeConfigValidator{}.ValidateResource(ctx, resource.ValidateConfigRequest{Config: newConfig(tt.restoreType, tt.block)}, resp)
+			assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError())
+		})
+	}
+}
+
+// TestSetImportedRestoreJobState tests rebuilding restore job inputs from the job's details on import
+func TestSetImportedRestoreJobState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewRestoreJobResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	newState := func() tfsdk.State {
+		return tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		}
+	}
+
+	newJob := func(restoreType externalEonSdkAPI.RestoreJobType) *externalEonSdkAPI.RestoreJob {
+		snapshotId := "snap-1"
+		pointInTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+		destination := externalEonSdkAPI.NewDestinationDetails("restore-account", "123456789012", externalEonSdkAPI.AWS, "us-west-2")
+		return &externalEonSdkAPI.RestoreJob{
+			JobExecutionDetails: externalEonSdkAPI.JobExecutionDetails{Status: externalEonSdkAPI.JOB_COMPLETED, CreatedTime: pointInTime},
+			SnapshotDetails:     *externalEonSdkAPI.NewNullableJobSnapshotDetails(&externalEonSdkAPI.JobSnapshotDetails{Id: &snapshotId, PointInTime: pointInTime}),
+			ResourceDetails:     *externalEonSdkAPI.NewNullableResourceDetails(&externalEonSdkAPI.ResourceDetails{Id: "resource-1"}),
+			DestinationDetails:  *destination,
+			RestoreType:         restoreType,
+		}
+	}
+
+	t.Run("ec2 instance", func(t *testing.T) {
+		t.Parallel()
+
+		state := newState()
+		diags := (&RestoreJobResource{}).setImportedRestoreJobState(ctx, &state, "job-1", newJob(externalEonSdkAPI.AWS_EC2_INSTANCE_RESTORE))
+		require.False(t, diags.HasError(), "%v", diags)
+
+		var data RestoreJobResourceModel
+		require.False(t, state.Get(ctx, &data).HasError())
+		assert.Equal(t, "job-1", data.JobId.ValueString())
+		assert.Equal(t, "full", data.RestoreType.ValueString())
+		assert.Equal(t, "snap-1", data.SnapshotId.ValueString())
+		assert.Equal(t, "resource-1", data.ResourceId.ValueString())
+		assert.Equal(t, "restore-account", data.RestoreAccountId.ValueString())
+		assert.Equal(t, "2024-06-01T12:00:00Z", data.RecoveryPointTime.ValueString())
+		assert.Equal(t, "JOB_COMPLETED", data.Status.ValueString())
+		require.NotNil(t, data.Ec2Config)
+		assert.Equal(t, "us-west-2", data.Ec2Config.Region.ValueString())
+		assert.True(t, data.Ec2Config.InstanceType.IsNull())
+		assert.Nil(t, data.EbsConfig)
+	})
+
+	t.Run("s3 files", func(t *testing.T) {
+		t.Parallel()
+
+		state := newState()
+		diags := (&RestoreJobResource{}).setImportedRestoreJobState(ctx, &state, "job-2", newJob(externalEonSdkAPI.AWS_S3_OBJECT_RESTORE))
+		require.False(t, diags.HasError(), "%v", diags)
+
+		var data RestoreJobResourceModel
+		require.False(t, state.Get(ctx, &data).HasError())
+		assert.Equal(t, "partial", data.RestoreType.ValueString())
+		require.NotNil(t, data.S3FileConfig)
+		assert.True(t, data.S3FileConfig.BucketName.IsNull())
+	})
+
+	t.Run("unsupported job type", func(t *testing.T) {
+		t.Parallel()
+
+		state := newState()
+		diags := (&RestoreJobResource{}).setImportedRestoreJobState(ctx, &state, "job-3", newJob(externalEonSdkAPI.AWS_EC2_FILE_RESTORE))
+		require.True(t, diags.HasError())
+		assert.Contains(t, diags.Errors()[0].Detail(), "AWS_EC2_FILE_RESTORE")
+	})
+}
+
+// TestRestoreJobModifyPlan tests that only restores that are about to start are checked at plan time
+func TestRestoreJobModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewRestoreJobResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	destination := externalEonSdkAPI.NewDestinationDetails("restore-account", "123456789012", externalEonSdkAPI.AWS, "eastus")
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}
+	diags := (&RestoreJobResource{}).setImportedRestoreJobState(ctx, &state, "job-1", &externalEonSdkAPI.RestoreJob{
+		DestinationDetails: *destination,
+		RestoreType:        externalEonSdkAPI.AWS_EC2_INSTANCE_RESTORE,
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	// The planned ec2_config.region isn't a valid AWS region, which fails the plan only for new restores
+	plan := tfsdk.Plan{Schema: state.Schema, Raw: state.Raw}
+	nullState := tfsdk.State{Schema: state.Schema, Raw: tftypes.NewValue(state.Raw.Type(), nil)}
+
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	NewRestoreJobResource().(*RestoreJobResource).ModifyPlan(ctx, resource.ModifyPlanRequest{State: state, Plan: plan}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	resp = &resource.ModifyPlanResponse{Plan: plan}
+	NewRestoreJobResource().(*RestoreJobResource).ModifyPlan(ctx, resource.ModifyPlanRequest{State: nullState, Plan: plan}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+// TestPlannedResourceId tests that a configured resource_id is kept and checked against the snapshot
+func TestPlannedResourceId(t *testing.T) {
+	t.Parallel()
+
+	snapshot := &externalEonSdkAPI.Snapshot{Id: "snap-1"}
+	snapshot.SetResourceId("resource-1")
+
+	tests := []struct {
+		name     string
+		config   types.String
+		expected types.String
+		wantErr  bool
+	}{
+		{"not configured", types.StringNull(), types.StringValue("resource-1"), false},
+		{"matches snapshot", types.StringValue("resource-1"), types.StringValue("resource-1"), false},
+		{"unknown", types.StringUnknown(), types.StringUnknown(), false},
+		{"other resource", types.StringValue("resource-2"), types.StringValue("resource-2"), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resourceId, diags := plannedResourceId(tt.config, snapshot)
+			assert.Equal(t, tt.wantErr, diags.HasError())
+			assert.Equal(t, tt.expected, resourceId)
+			if tt.wantErr {
+				assert.Equal(t, path.Root("resource_id"), diags.Errors()[0].(diag.DiagnosticWithPath).Path())
+			}
+		})
+	}
+}
+
+// TestRestoreConfigChanged tests which configuration block changes start a new restore job
+func TestRestoreConfigChanged(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{"region": types.StringType, "instance_type": types.StringType}
+	newObject := func(region, instanceType types.String) types.Object {
+		return types.ObjectValueMust(attrTypes, map[string]attr.Value{"region": region, "instance_type": instanceType})
+	}
+
+	imported := newObject(types.StringValue("us-east-1"), types.StringNull())
+
+	tests := []struct {
+		name     string
+		imported bool
+		state    types.Object
+		plan     types.Object
+		expected bool
+	}{
+		{"not imported", false, imported, newObject(types.StringValue("us-east-1"), types.StringValue("t3.medium")), true},
+		{"imported fills in unknown settings", true, imported, newObject(types.StringValue("us-east-1"), types.StringValue("t3.medium")), false},
+		{"imported changes known settings", true, imported, newObject(types.StringValue("us-west-2"), types.StringValue("t3.medium")), true},
+		{"imported adds block", true, types.ObjectNull(attrTypes), imported, true},
+		{"imported removes block", true, imported, types.ObjectNull(attrTypes), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, restoreConfigChanged(tt.imported, tt.state, tt.plan))
+		})
+	}
+}
+
+// newRestoreJobTestClient returns a client for a fake Eon API whose restore job "job-1" reports the given statuses
+// in turn, repeating the last one
+func newRestoreJobTestClient(t *testing.T, statuses ...externalEonSdkAPI.JobStatus) *client.EonClient {
+	t.Helper()
+
+	var reads atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		if strings.HasSuffix(r.URL.Path, "/token") {
+			_, _ = w.Write([]byte(`{"accessToken": "token", "expirationSeconds": 3600}`))
+			return
+		}
+
+		status := statuses[min(int(reads.Add(1))-1, len(statuses)-1)]
+		execution := map[string]interface{}{"jobId": "job-1", "status": status, "createdTime": "2024-06-01T12:00:00Z"}
+		if status == externalEonSdkAPI.JOB_FAILED {
+			execution["statusMessage"] = "snapshot is corrupt"
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"job": map[string]interface{}{
+			"jobExecutionDetails": execution,
+			"destinationDetails":  map[string]interface{}{"restoreAccountId": "account-1", "providerAccountId": "123456789012", "cloudProvider": "AWS", "region": "us-east-1"},
+			"restoreType":         "AWS_EC2_EBS_VOLUME_RESTORE",
+		}})
+	}))
+	t.Cleanup(server.Close)
+
+	c, err := client.NewEonClient(server.URL, "client-id", "client-secret", "project-id")
+	require.NoError(t, err)
+	c.PollConfig = client.PollConfig{Interval: 10 * time.Millisecond}
+	return c
+}
+
+// TestWaitForRestoreJobFailed tests that a restore job that fails while being waited for is reported
+func TestWaitForRestoreJobFailed(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := &RestoreJobResource{client: newRestoreJobTestClient(t, externalEonSdkAPI.JOB_RUNNING, externalEonSdkAPI.JOB_FAILED)}
+
+	data := &RestoreJobResourceModel{JobId: types.StringValue("job-1")}
+	diags := r.waitForRestoreJob(ctx, data, time.Minute, diag.SeverityError)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Restore Job Failed", diags.Errors()[0].Summary())
+	assert.Contains(t, diags.Errors()[0].Detail(), "JOB_FAILED: snapshot is corrupt")
+	assert.Equal(t, "JOB_FAILED", data.Status.ValueString())
+	assert.Equal(t, "snapshot is corrupt", data.StatusMessage.ValueString())
+}
+
+// TestRefreshRestoreJob tests that refreshes resume waiting for running restore jobs, and only warn when the
+// wait times out or the job fails
+func TestRefreshRestoreJob(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("timeout", func(t *testing.T) {
+		t.Parallel()
+
+		r := &RestoreJobResource{client: newRestoreJobTestClient(t, externalEonSdkAPI.JOB_RUNNING)}
+		data := &RestoreJobResourceModel{JobId: types.StringValue("job-1"), WaitForCompletion: types.BoolValue(true)}
+
+		timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+		defer cancel()
+		diags := r.refreshRestoreJob(timeoutCtx, data, 50*time.Millisecond)
+		assert.False(t, diags.HasError(), "%v", diags)
+		require.Len(t, diags.Warnings(), 1)
+		assert.Equal(t, "Restore Job Still Running", diags.Warnings()[0].Summary())
+		assert.Equal(t, "JOB_RUNNING", data.Status.ValueString())
+	})
+
+	t.Run("failed", func(t *testing.T) {
+		t.Parallel()
+
+		r := &RestoreJobResource{client: newRestoreJobTestClient(t, externalEonSdkAPI.JOB_RUNNING, externalEonSdkAPI.JOB_FAILED)}
+		data := &RestoreJobResourceModel{JobId: types.StringValue("job-1"), WaitForCompletion: types.BoolValue(true)}
+
+		diags := r.refreshRestoreJob(ctx, data, time.Minute)
+		assert.False(t, diags.HasError(), "%v", diags)
+		require.Len(t, diags.Warnings(), 1)
+		assert.Equal(t, "Restore Job Failed", diags.Warnings()[0].Summary())
+		assert.Equal(t, "JOB_FAILED", data.Status.ValueString())
+	})
+
+	t.Run("not waiting", func(t *testing.T) {
+		t.Parallel()
+
+		r := &RestoreJobResource{client: newRestoreJobTestClient(t, externalEonSdkAPI.JOB_RUNNING, externalEonSdkAPI.JOB_COMPLETED)}
+		data := &RestoreJobResourceModel{JobId: types.StringValue("job-1"), WaitForCompletion: types.BoolValue(false)}
+
+		diags := r.refreshRestoreJob(ctx, data, time.Minute)
+		assert.Empty(t, diags)
+		assert.Equal(t, "JOB_RUNNING", data.Status.ValueString())
+	})
+}
+
+// TestRdsRestoreConfigValidator tests rejecting unsupported rds_config attributes at validation time
+func TestRdsRestoreConfigValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewRestoreJobResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	// newConfig returns a configuration with rds_config set to the given attributes, and every other value null
+	newConfig := func(rdsValues map[string]tftypes.Value) tfsdk.Config {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attributeType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attributeType, nil)
+		}
+
+		blockType := objectType.AttributeTypes["rds_config"].(tftypes.Object)
+		blockValues := make(map[string]tftypes.Value, len(blockType.AttributeTypes))
+		for name, attributeType := range blockType.AttributeTypes {
+			blockValues[name] = tftypes.NewValue(attributeType, nil)
+		}
+		for name, value := range rdsValues {
+			blockValues[name] = value
+		}
+		values["rds_config"] = tftypes.NewValue(blockType, blockValues)
+
+		return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	}
+
+	resp := &resource.ValidateConfigResponse{}
+	rdsRestoreConfigValidator{}.ValidateResource(ctx, resource.ValidateConfigRequest{Config: newConfig(map[string]tftypes.Value{
+		"db_instance_identifier": tftypes.NewValue(tftypes.String, "restored-db"),
+	})}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+	resp = &resource.ValidateConfigResponse{}
+	rdsRestoreConfigValidator{}.ValidateResource(ctx, resource.ValidateConfigRequest{Config: newConfig(map[string]tftypes.Value{
+		"multi_az":          tftypes.NewValue(tftypes.Bool, true),
+		"allocated_storage": tftypes.NewValue(tftypes.String, "20GiB"),
+	})}, resp)
+	require.Len(t, resp.Diagnostics.Errors(), 2)
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "rds_config.allocated_storage")
+	assert.Contains(t, resp.Diagnostics.Errors()[1].Detail(), "rds_config.multi_az")
+}
@@ -0,0 +1,1186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &RestorePlanResource{}
+var _ resource.ResourceWithConfigValidators = &RestorePlanResource{}
+var _ resource.ResourceWithModifyPlan = &RestorePlanResource{}
+
+// Restore statuses for restores that have no Eon job
+const (
+	restorePlanStatusNotStarted  = "NOT_STARTED"
+	restorePlanStatusStartFailed = "START_FAILED"
+)
+
+// Aggregated restore plan statuses
+const (
+	restorePlanStatusRunning   = "RUNNING"
+	restorePlanStatusCompleted = "COMPLETED"
+	restorePlanStatusPartial   = "PARTIAL"
+	restorePlanStatusFailed    = "FAILED"
+)
+
+// defaultRestoredNameSuffix is appended to source names to name restored databases and tables
+const defaultRestoredNameSuffix = "-restored"
+
+func NewRestorePlanResource() resource.Resource {
+	return &RestorePlanResource{}
+}
+
+type RestorePlanResource struct {
+	client *client.EonClient
+}
+
+type RestorePlanResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	ResourceIds       types.List   `tfsdk:"resource_ids"`
+	ResourceSelector  types.Object `tfsdk:"resource_selector"`
+	RestoreAccountId  types.String `tfsdk:"restore_account_id"`
+	PointInTime       types.String `tfsdk:"point_in_time"`
+	Concurrency       types.Int64  `tfsdk:"concurrency"`
+	WaitForCompletion types.Bool   `tfsdk:"wait_for_completion"`
+	Triggers          types.Map    `tfsdk:"triggers"`
+
+	// Per resource type restore settings
+	Ec2Defaults      *Ec2RestoreDefaults      `tfsdk:"ec2_defaults"`
+	RdsDefaults      *RdsRestoreDefaults      `tfsdk:"rds_defaults"`
+	S3BucketDefaults *S3BucketRestoreDefaults `tfsdk:"s3_bucket_defaults"`
+	DynamoDbDefaults *DynamoDbRestoreDefaults `tfsdk:"dynamodb_defaults"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+
+	// Restore status fields (computed)
+	Status       types.String `tfsdk:"status"`
+	StatusCounts types.Map    `tfsdk:"status_counts"`
+	Restores     types.List   `tfsdk:"restores"`
+}
+
+type Ec2RestoreDefaults struct {
+	Region           types.String `tfsdk:"region"`
+	InstanceType     types.String `tfsdk:"instance_type"`
+	SubnetId         types.String `tfsdk:"subnet_id"`
+	SecurityGroupIds types.List   `tfsdk:"security_group_ids"`
+	VolumeType       types.String `tfsdk:"volume_type"`
+	KmsKeyId         types.String `tfsdk:"kms_key_id"`
+	Tags             types.Map    `tfsdk:"tags"`
+}
+
+type RdsRestoreDefaults struct {
+	Region              types.String `tfsdk:"region"`
+	KmsKeyId            types.String `tfsdk:"kms_key_id"`
+	SubnetGroupName     types.String `tfsdk:"subnet_group_name"`
+	VpcSecurityGroupIds types.List   `tfsdk:"vpc_security_group_ids"`
+	NameSuffix          types.String `tfsdk:"name_suffix"`
+	Tags                types.Map    `tfsdk:"tags"`
+}
+
+type S3BucketRestoreDefaults struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+	KeyPrefix  types.String `tfsdk:"key_prefix"`
+	KmsKeyId   types.String `tfsdk:"kms_key_id"`
+}
+
+type DynamoDbRestoreDefaults struct {
+	Region     types.String `tfsdk:"region"`
+	KmsKeyId   types.String `tfsdk:"kms_key_id"`
+	NameSuffix types.String `tfsdk:"name_suffix"`
+	Tags       types.Map    `tfsdk:"tags"`
+}
+
+type RestorePlanRestoreModel struct {
+	ResourceId        types.String `tfsdk:"resource_id"`
+	ResourceName      types.String `tfsdk:"resource_name"`
+	ResourceType      types.String `tfsdk:"resource_type"`
+	SnapshotId        types.String `tfsdk:"snapshot_id"`
+	RecoveryPointTime types.String `tfsdk:"recovery_point_time"`
+	JobId             types.String `tfsdk:"job_id"`
+	Status            types.String `tfsdk:"status"`
+	StatusMessage     types.String `tfsdk:"status_message"`
+	RestoredResources types.List   `tfsdk:"restored_resources"`
+}
+
+var restorePlanRestoreAttrTypes = map[string]attr.Type{
+	"resource_id":         types.StringType,
+	"resource_name":       types.StringType,
+	"resource_type":       types.StringType,
+	"snapshot_id":         types.StringType,
+	"recovery_point_time": types.StringType,
+	"job_id":              types.StringType,
+	"status":              types.StringType,
+	"status_message":      types.StringType,
+	"restored_resources":  types.ListType{ElemType: types.ObjectType{AttrTypes: restoredResourceAttrTypes}},
+}
+
+var volumeRestoreParamAttrTypes = map[string]attr.Type{
+	"provider_volume_id": types.StringType,
+	"volume_type":        types.StringType,
+	"volume_size":        SizeType{},
+	"iops":               types.Int64Type,
+	"throughput":         types.Int64Type,
+	"description":        types.StringType,
+	"kms_key_id":         types.StringType,
+}
+
+// restorePlanItem is one restore in a plan, with the restore job input used to start it
+type restorePlanItem struct {
+	model    RestorePlanRestoreModel
+	job      RestoreJobResourceModel
+	resource *externalEonSdkAPI.InventoryResource
+}
+
+func (r *RestorePlanResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_restore_plan"
+}
+
+func (r *RestorePlanResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	// Reuse the backup policy resource's selector schema so restore plans select resources the same way.
+	var policySchema resource.SchemaResponse
+	(&BackupPolicyResource{}).Schema(ctx, resource.SchemaRequest{}, &policySchema)
+	resourceSelector := policySchema.Schema.Attributes["resource_selector"].(schema.SingleNestedAttribute)
+	resourceSelector.MarkdownDescription = "Selects the inventory resources to restore, in the same format as the `eon_backup_policy` `resource_selector`. Can't be used with `resource_ids`."
+	resourceSelector.Required = false
+	resourceSelector.Optional = true
+	resourceSelector.PlanModifiers = []planmodifier.Object{objectplanmodifier.RequiresReplace()}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Restores many resources at once, such as for a disaster recovery drill. Starts one restore job per selected resource from its snapshot at `point_in_time`, using the settings for its resource type, and polls the jobs until they finish. Supports AWS EC2 instances, RDS databases, S3 buckets and DynamoDB tables. Like `eon_restore_job`, destroying a restore plan only removes it from Terraform state; restored resources aren't deleted.\n\nThe `create`, `read` and `update` timeouts default to 60 minutes, the same as `eon_restore_job`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Restore plan ID, generated by the provider.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"resource_ids": schema.ListAttribute{
+				MarkdownDescription: "Eon-assigned IDs of the resources to restore. Can't be used with `resource_selector`.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.RequiresReplace()},
+			},
+			"resource_selector": resourceSelector,
+			"restore_account_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the restore account to restore every resource to.",
+				Required:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"point_in_time": schema.StringAttribute{
+				MarkdownDescription: "Date and time to restore the resources to, in RFC 3339 format. Each resource is restored from its latest snapshot taken at or before this time. Defaults to each resource's latest snapshot.",
+				Optional:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"concurrency": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of restore jobs to run at the same time. Defaults to `5`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(5),
+				Validators:          []validator.Int64{int64validator.AtLeast(1)},
+			},
+			"wait_for_completion": schema.BoolAttribute{
+				MarkdownDescription: "Whether to wait for every restore job to finish. When `false`, the apply still waits until every restore job has started, because `concurrency` limits how many run at once. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that rerun the restore plan when changed, such as a drill date.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.RequiresReplace()},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Aggregated status of the restores: `RUNNING` while any restore hasn't finished, `COMPLETED` when every restore completed, `FAILED` when none did, and `PARTIAL` otherwise.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"status_counts": schema.MapAttribute{
+				MarkdownDescription: "Number of restores per status. Restore job statuses are `JOB_PENDING`, `JOB_RUNNING`, `JOB_COMPLETED`, `JOB_PARTIAL`, `JOB_FAILED` and `JOB_CANCELLED`. Restores that couldn't be started are `START_FAILED`, and restores still waiting for a slot are `NOT_STARTED`. Restores that hadn't started when the timeout expired stay `NOT_STARTED`, and the next apply starts them without rerunning the others.",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+				PlanModifiers:       []planmodifier.Map{mapplanmodifier.UseStateForUnknown()},
+			},
+			"restores": schema.ListNestedAttribute{
+				MarkdownDescription: "Restores started by the plan, one per selected resource.",
+				Computed:            true,
+				PlanModifiers:       []planmodifier.List{listplanmodifier.UseStateForUnknown()},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_id": schema.StringAttribute{
+							MarkdownDescription: "Eon-assigned ID of the restored resource.",
+							Computed:            true,
+						},
+						"resource_name": schema.StringAttribute{
+							MarkdownDescription: "Display name of the restored resource.",
+							Computed:            true,
+						},
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the restored resource.",
+							Computed:            true,
+						},
+						"snapshot_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the snapshot the resource was restored from.",
+							Computed:            true,
+						},
+						"recovery_point_time": schema.StringAttribute{
+							MarkdownDescription: "Date and time the snapshot was taken.",
+							Computed:            true,
+						},
+						"job_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the restore job. Empty when the restore couldn't be started.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Restore job status, or `START_FAILED` or `NOT_STARTED` when there's no restore job.",
+							Computed:            true,
+						},
+						"status_message": schema.StringAttribute{
+							MarkdownDescription: "Restore job status message, or why the restore couldn't be started.",
+							Computed:            true,
+						},
+						"restored_resources": schema.ListNestedAttribute{
+							MarkdownDescription: "Resources created by the restore job, when Eon reports them.",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: restoredResourceAttributes(),
+							},
+						},
+					},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"ec2_defaults": schema.SingleNestedBlock{
+				MarkdownDescription: "Settings for restoring AWS EC2 instances. Every volume in the snapshot is restored with its original size.",
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.RequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Region to restore instances to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"instance_type": schema.StringAttribute{
+						MarkdownDescription: "EC2 instance type of the restored instances.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"subnet_id": schema.StringAttribute{
+						MarkdownDescription: "Subnet to restore instances to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"security_group_ids": schema.ListAttribute{
+						MarkdownDescription: "Security groups for the restored instances.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"volume_type": schema.StringAttribute{
+						MarkdownDescription: "EBS volume type for the restored volumes. Defaults to each source volume's type.",
+						Optional:            true,
+					},
+					"kms_key_id": schema.StringAttribute{
+						MarkdownDescription: "ARN of the KMS key for encrypting the restored volumes.",
+						Optional:            true,
+					},
+					"tags": schema.MapAttribute{
+						MarkdownDescription: "Tags to apply to the restored instances.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"rds_defaults": schema.SingleNestedBlock{
+				MarkdownDescription: "Settings for restoring AWS RDS databases. Each database is restored as its source name followed by `name_suffix`.",
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.RequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Region to restore databases to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"kms_key_id": schema.StringAttribute{
+						MarkdownDescription: "ARN of the KMS key for encrypting the restored databases.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"subnet_group_name": schema.StringAttribute{
+						MarkdownDescription: "DB subnet group for the restored databases.",
+						Optional:            true,
+					},
+					"vpc_security_group_ids": schema.ListAttribute{
+						MarkdownDescription: "VPC security groups for the restored databases.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+					"name_suffix": schema.StringAttribute{
+						MarkdownDescription: "Suffix appended to each source database name. Defaults to `-restored`.",
+						Optional:            true,
+					},
+					"tags": schema.MapAttribute{
+						MarkdownDescription: "Tags to apply to the restored databases.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"s3_bucket_defaults": schema.SingleNestedBlock{
+				MarkdownDescription: "Settings for restoring AWS S3 buckets. Each bucket is restored to `bucket_name` under `<key_prefix><source bucket name>/`.",
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.RequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"bucket_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the bucket to restore to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"key_prefix": schema.StringAttribute{
+						MarkdownDescription: "Prefix for the restored objects, before the source bucket name.",
+						Optional:            true,
+					},
+					"kms_key_id": schema.StringAttribute{
+						MarkdownDescription: "ARN of the KMS key for encrypting the restored objects.",
+						Optional:            true,
+					},
+				},
+			},
+			"dynamodb_defaults": schema.SingleNestedBlock{
+				MarkdownDescription: "Settings for restoring AWS DynamoDB tables. Each table is restored as its source name followed by `name_suffix`.",
+				PlanModifiers:       []planmodifier.Object{objectplanmodifier.RequiresReplace()},
+				Attributes: map[string]schema.Attribute{
+					"region": schema.StringAttribute{
+						MarkdownDescription: "Region to restore tables to.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"kms_key_id": schema.StringAttribute{
+						MarkdownDescription: "ARN of the KMS key for encrypting the restored tables.",
+						Required:            true,
+						Validators:          []validator.String{stringvalidator.LengthAtLeast(1)},
+					},
+					"name_suffix": schema.StringAttribute{
+						MarkdownDescription: "Suffix appended to each source table name. Defaults to `-restored`.",
+						Optional:            true,
+					},
+					"tags": schema.MapAttribute{
+						MarkdownDescription: "Tags to apply to the restored tables.",
+						ElementType:         types.StringType,
+						Optional:            true,
+					},
+				},
+			},
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// ConfigValidators requires exactly one way of selecting the resources to restore
+func (r *RestorePlanResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("resource_ids"), path.MatchRoot("resource_selector")),
+	}
+}
+
+func (r *RestorePlanResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.EonClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.EonClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RestorePlanResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RestorePlanResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, timeoutDiags := data.Timeouts.Create(ctx, defaultRestoreTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var pointInTime *time.Time
+	if !data.PointInTime.IsNull() && data.PointInTime.ValueString() != "" {
+		parsed, err := time.Parse(time.RFC3339, data.PointInTime.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("Invalid point_in_time %q, expected RFC 3339 format such as 2024-01-02T15:04:05Z: %s", data.PointInTime.ValueString(), err))
+			return
+		}
+		pointInTime = &parsed
+	}
+
+	inventoryResources, selectDiags := r.selectResources(ctx, data)
+	resp.Diagnostics.Append(selectDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(inventoryResources) == 0 {
+		resp.Diagnostics.AddError("Configuration Error", "The restore plan doesn't select any resources")
+		return
+	}
+
+	if missing := missingRestoreDefaults(data, inventoryResources); len(missing) > 0 {
+		resp.Diagnostics.AddError("Configuration Error", fmt.Sprintf("The restore plan selects resources without restore settings: %s", strings.Join(missing, "; ")))
+		return
+	}
+
+	restoreJobs := &RestoreJobResource{client: r.client}
+	if err := restoreJobs.validateRestoreAccount(ctx, data.RestoreAccountId.ValueString(), externalEonSdkAPI.AWS); err != nil {
+		resp.Diagnostics.AddError("Configuration Error", err.Error())
+		return
+	}
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		resp.Diagnostics.AddError("Internal Error", fmt.Sprintf("Unable to generate restore plan ID: %s", err))
+		return
+	}
+	data.Id = types.StringValue(id)
+
+	items := make([]*restorePlanItem, 0, len(inventoryResources))
+	for i := range inventoryResources {
+		item, itemDiags := r.newRestorePlanItem(ctx, data, &inventoryResources[i], pointInTime)
+		resp.Diagnostics.Append(itemDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		items = append(items, item)
+	}
+
+	tflog.Info(ctx, "Starting restore plan", map[string]interface{}{
+		"id":          id,
+		"restores":    len(items),
+		"concurrency": data.Concurrency.ValueInt64(),
+	})
+
+	resp.Diagnostics.Append(r.runRestores(ctx, items, int(data.Concurrency.ValueInt64()), data.WaitForCompletion.ValueBool())...)
+	resp.Diagnostics.Append(setRestorePlanRestores(ctx, &data, items)...)
+
+	started := 0
+	for _, item := range items {
+		if item.model.JobId.ValueString() != "" {
+			started++
+		}
+	}
+	if started == 0 && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to start any of the restore plan's restores: %s", restorePlanFailures(items)))
+	} else if data.Status.ValueString() == restorePlanStatusPartial || data.Status.ValueString() == restorePlanStatusFailed {
+		resp.Diagnostics.AddWarning(
+			"Restore Plan Not Fully Completed",
+			fmt.Sprintf("Restore plan %s finished with status %s. Failed restores: %s", id, data.Status.ValueString(), restorePlanFailures(items)),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// selectResources returns the inventory resources selected by resource_ids or resource_selector
+func (r *RestorePlanResource) selectResources(ctx context.Context, data RestorePlanResourceModel) ([]externalEonSdkAPI.InventoryResource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	hasResourceIds := !data.ResourceIds.IsNull()
+	hasSelector := !data.ResourceSelector.IsNull()
+
+	switch {
+	case hasResourceIds && hasSelector:
+		diags.AddError("Configuration Error", "Only one of resource_ids or resource_selector can be set")
+		return nil, diags
+
+	case hasResourceIds:
+		var resourceIds []string
+		diags.Append(data.ResourceIds.ElementsAs(ctx, &resourceIds, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		resources := make([]externalEonSdkAPI.InventoryResource, 0, len(resourceIds))
+		for _, resourceId := range resourceIds {
+			inventoryResource, err := r.client.GetResourceById(ctx, resourceId)
+			if err != nil {
+				diags.AddError("Client Error", fmt.Sprintf("Unable to retrieve resource with ID %s: %s", resourceId, err))
+				return nil, diags
+			}
+			resources = append(resources, *inventoryResource)
+		}
+		return resources, diags
+
+	case hasSelector:
+		selector, selectorDiags := createBackupPolicyResourceSelector(ctx, data.ResourceSelector)
+		diags.Append(selectorDiags...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		inventory, err := r.client.ListResources(ctx, nil)
+		if err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to read inventory resources: %s", err))
+			return nil, diags
+		}
+
+		var resources []externalEonSdkAPI.InventoryResource
+		for _, inventoryResource := range inventory {
+			matches, err := backupPolicySelectorMatches(selector, &inventoryResource)
+			if err != nil {
+				diags.AddError("Invalid Conditional Expression", fmt.Sprintf("Unable to evaluate resource_selector: %s", err))
+				return nil, diags
+			}
+			if matches {
+				resources = append(resources, inventoryResource)
+			}
+		}
+		return resources, diags
+
+	default:
+		diags.AddError("Configuration Error", "Either resource_ids or resource_selector must be set")
+		return nil, diags
+	}
+}
+
+// missingRestoreDefaults describes the selected resources whose resource type has no settings block
+func missingRestoreDefaults(data RestorePlanResourceModel, resources []externalEonSdkAPI.InventoryResource) []string {
+	resourceIdsByType := map[string][]string{}
+	for _, inventoryResource := range resources {
+		resourceType := inventoryResource.GetResourceType()
+		var supported bool
+		switch resourceType {
+		case externalEonSdkAPI.AWS_EC2:
+			supported = data.Ec2Defaults != nil
+		case externalEonSdkAPI.AWS_RDS:
+			supported = data.RdsDefaults != nil
+		case externalEonSdkAPI.AWS_S3:
+			supported = data.S3BucketDefaults != nil
+		case externalEonSdkAPI.AWS_DYNAMO_DB:
+			supported = data.DynamoDbDefaults != nil
+		}
+		if !supported {
+			resourceIdsByType[string(resourceType)] = append(resourceIdsByType[string(resourceType)], inventoryResource.Id)
+		}
+	}
+
+	var missing []string
+	for resourceType, resourceIds := range resourceIdsByType {
+		var block string
+		switch externalEonSdkAPI.ResourceType(resourceType) {
+		case externalEonSdkAPI.AWS_EC2:
+			block = "ec2_defaults is required"
+		case externalEonSdkAPI.AWS_RDS:
+			block = "rds_defaults is required"
+		case externalEonSdkAPI.AWS_S3:
+			block = "s3_bucket_defaults is required"
+		case externalEonSdkAPI.AWS_DYNAMO_DB:
+			block = "dynamodb_defaults is required"
+		default:
+			block = "restore plans don't support this resource type"
+		}
+		missing = append(missing, fmt.Sprintf("%s (%s): %s", resourceType, block, strings.Join(resourceIds, ", ")))
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+// newRestorePlanItem resolves the snapshot to restore a resource from and builds its restore job input.
+// Resources that can't be restored are recorded with status START_FAILED rather than failing the plan.
+func (r *RestorePlanResource) newRestorePlanItem(ctx context.Context, data RestorePlanResourceModel, inventoryResource *externalEonSdkAPI.InventoryResource, pointInTime *time.Time) (*restorePlanItem, diag.Diagnostics) {
+	item := &restorePlanItem{
+		model: RestorePlanRestoreModel{
+			ResourceId:        types.StringValue(inventoryResource.Id),
+			ResourceName:      types.StringValue(inventoryResource.ResourceName),
+			ResourceType:      types.StringValue(string(inventoryResource.GetResourceType())),
+			SnapshotId:        types.StringNull(),
+			RecoveryPointTime: types.StringNull(),
+			JobId:             types.StringNull(),
+			Status:            types.StringValue(restorePlanStatusNotStarted),
+			StatusMessage:     types.StringNull(),
+			RestoredResources: types.ListValueMust(types.ObjectType{AttrTypes: restoredResourceAttrTypes}, []attr.Value{}),
+		},
+		resource: inventoryResource,
+	}
+
+	var snapshot *externalEonSdkAPI.Snapshot
+	var err error
+	if pointInTime != nil {
+		snapshot, err = r.client.GetSnapshotAtPointInTime(ctx, inventoryResource.Id, *pointInTime)
+	} else {
+		snapshot, err = r.client.GetLatestSnapshot(ctx, inventoryResource.Id)
+	}
+	if err != nil {
+		item.startFailed(fmt.Sprintf("unable to find a snapshot: %s", err))
+		return item, nil
+	}
+	if snapshot == nil {
+		if pointInTime != nil {
+			item.startFailed(fmt.Sprintf("no snapshot taken at or before %s", pointInTime.Format(time.RFC3339)))
+		} else {
+			item.startFailed("no snapshots")
+		}
+		return item, nil
+	}
+
+	item.model.SnapshotId = types.StringValue(snapshot.Id)
+	if snapshot.PointInTime != nil {
+		item.model.RecoveryPointTime = types.StringValue(snapshot.PointInTime.Format(time.RFC3339))
+	}
+
+	return item, item.setJob(ctx, data, snapshot)
+}
+
+// prepareNotStartedRestore rebuilds the restore job input of a restore that hadn't started when a previous apply
+// stopped, from the resource and snapshot recorded in state. Restores that can no longer be started are recorded
+// with status START_FAILED.
+func (r *RestorePlanResource) prepareNotStartedRestore(ctx context.Context, data RestorePlanResourceModel, item *restorePlanItem) diag.Diagnostics {
+	inventoryResource, err := r.client.GetResourceById(ctx, item.model.ResourceId.ValueString())
+	if err != nil {
+		item.startFailed(fmt.Sprintf("unable to read resource: %s", err))
+		return nil
+	}
+	item.resource = inventoryResource
+
+	snapshot, err := r.client.GetSnapshot(ctx, item.model.SnapshotId.ValueString())
+	if err != nil {
+		item.startFailed(fmt.Sprintf("unable to read snapshot %s: %s", item.model.SnapshotId.ValueString(), err))
+		return nil
+	}
+
+	return item.setJob(ctx, data, snapshot)
+}
+
+// startFailed records that the restore couldn't be started
+func (item *restorePlanItem) startFailed(message string) {
+	item.model.Status = types.StringValue(restorePlanStatusStartFailed)
+	item.model.StatusMessage = types.StringValue(message)
+}
+
+// setJob builds the restore job input for restoring the item's resource from snapshot, using the settings for its
+// resource type
+func (item *restorePlanItem) setJob(ctx context.Context, data RestorePlanResourceModel, snapshot *externalEonSdkAPI.Snapshot) diag.Diagnostics {
+	inventoryResource := item.resource
+	item.job = RestoreJobResourceModel{
+		SnapshotId:       types.StringValue(snapshot.Id),
+		RestoreAccountId: data.RestoreAccountId,
+	}
+	name := restoredResourceName(inventoryResource)
+
+	switch inventoryResource.GetResourceType() {
+	case externalEonSdkAPI.AWS_EC2:
+		defaults := data.Ec2Defaults
+		volumeParams := restorePlanVolumeParams(snapshot, defaults)
+		if len(volumeParams) == 0 {
+			item.startFailed(fmt.Sprintf("snapshot %s doesn't list the instance's volumes", snapshot.Id))
+			return nil
+		}
+		volumeParamList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: volumeRestoreParamAttrTypes}, volumeParams)
+		if diags.HasError() {
+			return diags
+		}
+		item.job.Ec2Config = &Ec2RestoreConfig{
+			Region:              defaults.Region,
+			InstanceType:        defaults.InstanceType,
+			SubnetId:            defaults.SubnetId,
+			SecurityGroupIds:    defaults.SecurityGroupIds,
+			Tags:                defaults.Tags,
+			VolumeRestoreParams: volumeParamList,
+		}
+	case externalEonSdkAPI.AWS_RDS:
+		defaults := data.RdsDefaults
+		item.job.RdsConfig = &RdsRestoreConfig{
+			DbInstanceIdentifier: types.StringValue(name + restoredNameSuffix(defaults.NameSuffix)),
+			Region:               defaults.Region,
+			KmsKeyId:             defaults.KmsKeyId,
+			SubnetGroupName:      defaults.SubnetGroupName,
+			VpcSecurityGroupIds:  defaults.VpcSecurityGroupIds,
+			Tags:                 defaults.Tags,
+			AllocatedStorage:     NewSizeNull(),
+		}
+	case externalEonSdkAPI.AWS_S3:
+		defaults := data.S3BucketDefaults
+		item.job.S3BucketConfig = &S3BucketRestoreConfig{
+			BucketName: defaults.BucketName,
+			KeyPrefix:  types.StringValue(defaults.KeyPrefix.ValueString() + name + "/"),
+			KmsKeyId:   defaults.KmsKeyId,
+		}
+	case externalEonSdkAPI.AWS_DYNAMO_DB:
+		defaults := data.DynamoDbDefaults
+		item.job.DynamoDbConfig = &DynamoDbRestoreConfig{
+			TableName: types.StringValue(name + restoredNameSuffix(defaults.NameSuffix)),
+			Region:    defaults.Region,
+			KmsKeyId:  defaults.KmsKeyId,
+			Tags:      defaults.Tags,
+		}
+	}
+
+	return nil
+}
+
+// restoredResourceName returns the name restored resources are named after
+func restoredResourceName(inventoryResource *externalEonSdkAPI.InventoryResource) string {
+	if inventoryResource.ResourceName != "" {
+		return inventoryResource.ResourceName
+	}
+	return inventoryResource.ProviderResourceId
+}
+
+// restoredNameSuffix returns name_suffix, or the default suffix when it isn't set
+func restoredNameSuffix(nameSuffix types.String) string {
+	if nameSuffix.IsNull() {
+		return defaultRestoredNameSuffix
+	}
+	return nameSuffix.ValueString()
+}
+
+// restorePlanVolumeParams restores every volume in an EC2 instance snapshot at its original size.
+// Source IOPS and throughput are only kept when the volume type isn't changed.
+func restorePlanVolumeParams(snapshot *externalEonSdkAPI.Snapshot, defaults *Ec2RestoreDefaults) []VolumeRestoreParam {
+	volumes := snapshotVolumes(snapshot)
+	params := make([]VolumeRestoreParam, 0, len(volumes))
+	for _, volume := range volumes {
+		settings := volume.VolumeSettings
+		param := VolumeRestoreParam{
+			ProviderVolumeId: types.StringValue(volume.ProviderVolumeId),
+			VolumeType:       types.StringValue(settings.Type),
+			VolumeSize:       NewSizeValue(formatSize(settings.SizeBytes)),
+			Iops:             types.Int64Null(),
+			Throughput:       types.Int64Null(),
+			Description:      types.StringNull(),
+			KmsKeyId:         defaults.KmsKeyId,
+		}
+
+		if !defaults.VolumeType.IsNull() && defaults.VolumeType.ValueString() != settings.Type {
+			param.VolumeType = defaults.VolumeType
+		} else {
+			if settings.Iops != nil {
+				param.Iops = types.Int64Value(int64(*settings.Iops))
+			}
+			if settings.Throughput != nil {
+				param.Throughput = types.Int64Value(int64(*settings.Throughput))
+			}
+		}
+
+		params = append(params, param)
+	}
+	return params
+}
+
+// startRestore starts the restore job for one resource in the plan
+func (r *RestorePlanResource) startRestore(ctx context.Context, item *restorePlanItem) (string, error) {
+	restoreJobs := &RestoreJobResource{client: r.client}
+	resourceId := item.resource.Id
+
+	switch item.resource.GetResourceType() {
+	case externalEonSdkAPI.AWS_EC2:
+		return restoreJobs.createEc2InstanceRestore(ctx, item.job, resourceId)
+	case externalEonSdkAPI.AWS_RDS:
+		return restoreJobs.createRdsRestore(ctx, item.job, resourceId)
+	case externalEonSdkAPI.AWS_S3:
+		return restoreJobs.createS3BucketRestore(ctx, item.job, resourceId)
+	case externalEonSdkAPI.AWS_DYNAMO_DB:
+		return restoreJobs.createDynamoDbRestore(ctx, item.job, resourceId)
+	default:
+		return "", fmt.Errorf("restore plans don't support resource type %s", item.resource.GetResourceType())
+	}
+}
+
+// runRestores starts the NOT_STARTED restores, keeping at most concurrency restore jobs running, and polls the
+// running jobs. It returns once every restore has started, or has finished when waitForCompletion is set. A
+// concurrency of 0 doesn't start any restores, and only waits for the running ones.
+func (r *RestorePlanResource) runRestores(ctx context.Context, items []*restorePlanItem, concurrency int, waitForCompletion bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var pending, running []*restorePlanItem
+	for _, item := range items {
+		switch {
+		case item.model.Status.ValueString() == restorePlanStatusNotStarted:
+			pending = append(pending, item)
+		case item.model.JobId.ValueString() != "" && !isTerminalJobStatus(externalEonSdkAPI.JobStatus(item.model.Status.ValueString())):
+			running = append(running, item)
+		}
+	}
+
+	started := time.Now()
+	for {
+		// Restores aren't started once the timeout has expired, so they stay NOT_STARTED for the next apply
+		for len(running) < concurrency && len(pending) > 0 && ctx.Err() == nil {
+			item := pending[0]
+			pending = pending[1:]
+
+			jobId, err := r.startRestore(ctx, item)
+			if err != nil {
+				tflog.Warn(ctx, "Unable to start restore", map[string]interface{}{
+					"resource_id": item.resource.Id,
+					"error":       err.Error(),
+				})
+				item.model.Status = types.StringValue(restorePlanStatusStartFailed)
+				item.model.StatusMessage = types.StringValue(err.Error())
+				continue
+			}
+
+			tflog.Debug(ctx, "Started restore job", map[string]interface{}{
+				"job_id":      jobId,
+				"resource_id": item.resource.Id,
+				"snapshot_id": item.model.SnapshotId.ValueString(),
+			})
+			item.model.JobId = types.StringValue(jobId)
+			item.model.Status = types.StringValue(string(externalEonSdkAPI.JOB_PENDING))
+			running = append(running, item)
+		}
+
+		if (len(pending) == 0 || concurrency == 0) && (len(running) == 0 || !waitForCompletion) {
+			return diags
+		}
+
+		// Running restores are polled like WaitForRestoreJobCompletion polls a single job. Polling stops once a
+		// restore finishes and frees a slot for a pending one, so it starts at the initial interval again after
+		// starting more restores.
+		_, err := client.Poll(ctx, r.client.PollConfig, func(ctx context.Context) (struct{}, bool, error) {
+			var pollDiags diag.Diagnostics
+			running, pollDiags = r.pollRestores(ctx, running)
+			diags.Append(pollDiags...)
+
+			tflog.Info(ctx, "Waiting for restore plan", map[string]interface{}{
+				"pending":  len(pending),
+				"running":  len(running),
+				"finished": len(items) - len(pending) - len(running),
+				"elapsed":  time.Since(started).Round(time.Second).String(),
+			})
+			return struct{}{}, len(running) == 0 || (len(pending) > 0 && len(running) < concurrency), nil
+		})
+		if err != nil {
+			if len(pending) > 0 && concurrency > 0 {
+				diags.AddWarning(
+					"Restore Plan Not Fully Started",
+					fmt.Sprintf("%d of %d restores hadn't started when the timeout expired. They're recorded as NOT_STARTED, and the next apply starts them without rerunning the others. Increase the timeout or concurrency to start every restore in one apply.", len(pending), len(items)),
+				)
+			} else {
+				diags.AddWarning(
+					"Restore Plan Still Running",
+					fmt.Sprintf("%d of %d restores didn't finish within the timeout. While wait_for_completion is true, the next plan or apply resumes waiting for them.", len(running), len(items)),
+				)
+			}
+			return diags
+		}
+	}
+}
+
+// pollRestores records the status of running restores and returns the ones that are still running. Restores
+// whose status can't be read are kept, so they're polled again.
+func (r *RestorePlanResource) pollRestores(ctx context.Context, running []*restorePlanItem) ([]*restorePlanItem, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	stillRunning := running[:0]
+	for _, item := range running {
+		job, err := r.client.GetRestoreJob(ctx, item.model.JobId.ValueString())
+		if err != nil {
+			tflog.Warn(ctx, "Unable to read restore job status", map[string]interface{}{
+				"job_id": item.model.JobId.ValueString(),
+				"error":  err.Error(),
+			})
+			stillRunning = append(stillRunning, item)
+			continue
+		}
+
+		diags.Append(updateRestorePlanRestore(ctx, &item.model, job)...)
+		if !isTerminalJobStatus(job.GetJobExecutionDetails().Status) {
+			stillRunning = append(stillRunning, item)
+		}
+	}
+	return stillRunning, diags
+}
+
+// updateRestorePlanRestore records a restore job's status and restored resources
+func updateRestorePlanRestore(ctx context.Context, model *RestorePlanRestoreModel, job *externalEonSdkAPI.RestoreJob) diag.Diagnostics {
+	model.Status = types.StringValue(string(job.GetJobExecutionDetails().Status))
+	if job.GetJobExecutionDetails().StatusMessage != nil {
+		model.StatusMessage = types.StringValue(*job.GetJobExecutionDetails().StatusMessage)
+	} else {
+		model.StatusMessage = types.StringNull()
+	}
+
+	restoredResources, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: restoredResourceAttrTypes}, newRestoredResourceModels(job))
+	model.RestoredResources = restoredResources
+	return diags
+}
+
+// setRestorePlanRestores records the restores and their aggregated status
+func setRestorePlanRestores(ctx context.Context, data *RestorePlanResourceModel, items []*restorePlanItem) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	models := make([]RestorePlanRestoreModel, 0, len(items))
+	statuses := make([]string, 0, len(items))
+	statusCounts := map[string]int64{}
+	for _, item := range items {
+		models = append(models, item.model)
+		statuses = append(statuses, item.model.Status.ValueString())
+		statusCounts[item.model.Status.ValueString()]++
+	}
+
+	restores, restoresDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: restorePlanRestoreAttrTypes}, models)
+	diags.Append(restoresDiags...)
+	counts, countsDiags := types.MapValueFrom(ctx, types.Int64Type, statusCounts)
+	diags.Append(countsDiags...)
+
+	data.Restores = restores
+	data.StatusCounts = counts
+	data.Status = types.StringValue(restorePlanStatus(statuses))
+	return diags
+}
+
+// restorePlanStatus aggregates the statuses of a plan's restores
+func restorePlanStatus(statuses []string) string {
+	completed, failed := 0, 0
+	for _, status := range statuses {
+		switch status {
+		case string(externalEonSdkAPI.JOB_COMPLETED):
+			completed++
+		case string(externalEonSdkAPI.JOB_FAILED), string(externalEonSdkAPI.JOB_CANCELLED), restorePlanStatusStartFailed:
+			failed++
+		case string(externalEonSdkAPI.JOB_PARTIAL):
+		default:
+			return restorePlanStatusRunning
+		}
+	}
+
+	switch {
+	case completed == len(statuses):
+		return restorePlanStatusCompleted
+	case failed == len(statuses):
+		return restorePlanStatusFailed
+	default:
+		return restorePlanStatusPartial
+	}
+}
+
+// restorePlanFailures describes the restores that didn't complete
+func restorePlanFailures(items []*restorePlanItem) string {
+	var failures []string
+	for _, item := range items {
+		status := item.model.Status.ValueString()
+		switch status {
+		case string(externalEonSdkAPI.JOB_COMPLETED), string(externalEonSdkAPI.JOB_PENDING), string(externalEonSdkAPI.JOB_RUNNING), restorePlanStatusNotStarted:
+			continue
+		}
+
+		failure := fmt.Sprintf("%s (%s)", item.model.ResourceId.ValueString(), status)
+		if message := item.model.StatusMessage.ValueString(); message != "" {
+			failure += ": " + message
+		}
+		failures = append(failures, failure)
+	}
+	return strings.Join(failures, "; ")
+}
+
+// restorePlanItems reads the recorded restores back into plan items so they can be polled
+func restorePlanItems(ctx context.Context, data RestorePlanResourceModel) ([]*restorePlanItem, diag.Diagnostics) {
+	var models []RestorePlanRestoreModel
+	diags := data.Restores.ElementsAs(ctx, &models, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	items := make([]*restorePlanItem, 0, len(models))
+	for _, model := range models {
+		items = append(items, &restorePlanItem{
+			model:    model,
+			resource: &externalEonSdkAPI.InventoryResource{Id: model.ResourceId.ValueString(), ResourceType: externalEonSdkAPI.ResourceType(model.ResourceType.ValueString())},
+		})
+	}
+	return items, diags
+}
+
+func (r *RestorePlanResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RestorePlanResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, timeoutDiags := data.Timeouts.Read(ctx, defaultRestoreTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	items, itemDiags := restorePlanItems(ctx, data)
+	resp.Diagnostics.Append(itemDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Finished restore jobs don't change, so only refresh the ones still running
+	var running []*restorePlanItem
+	for _, item := range items {
+		if item.model.JobId.ValueString() == "" || isTerminalJobStatus(externalEonSdkAPI.JobStatus(item.model.Status.ValueString())) {
+			continue
+		}
+
+		job, err := r.client.GetRestoreJob(ctx, item.model.JobId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore job %s: %s", item.model.JobId.ValueString(), err))
+			return
+		}
+		resp.Diagnostics.Append(updateRestorePlanRestore(ctx, &item.model, job)...)
+		if !isTerminalJobStatus(job.GetJobExecutionDetails().Status) {
+			running = append(running, item)
+		}
+	}
+
+	// Resume waiting on restores that were still running when a previous apply stopped waiting. Restores that
+	// hadn't started aren't started by a refresh; ModifyPlan plans an update that starts them.
+	if data.WaitForCompletion.ValueBool() && len(running) > 0 {
+		tflog.Info(ctx, "Resuming wait for restore plan", map[string]interface{}{
+			"id":      data.Id.ValueString(),
+			"running": len(running),
+		})
+		resp.Diagnostics.Append(r.runRestores(ctx, items, 0, true)...)
+	}
+
+	resp.Diagnostics.Append(setRestorePlanRestores(ctx, &data, items)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RestorePlanResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan RestorePlanResourceModel
+	var state RestorePlanResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Every restore input requires replacement, so an update only changes how the provider runs and waits
+	// for the restores, and starts the restores that hadn't started when a previous apply stopped.
+	plan.Id = state.Id
+	plan.Status = state.Status
+	plan.StatusCounts = state.StatusCounts
+	plan.Restores = state.Restores
+
+	items, itemDiags := restorePlanItems(ctx, state)
+	resp.Diagnostics.Append(itemDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if notStarted := countRestorePlanStatus(items, restorePlanStatusNotStarted); notStarted > 0 {
+		updateTimeout, timeoutDiags := plan.Timeouts.Update(ctx, defaultRestoreTimeout)
+		resp.Diagnostics.Append(timeoutDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+		defer cancel()
+
+		for _, item := range items {
+			if item.model.Status.ValueString() == restorePlanStatusNotStarted {
+				resp.Diagnostics.Append(r.prepareNotStartedRestore(ctx, plan, item)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+			}
+		}
+
+		tflog.Info(ctx, "Starting remaining restores of restore plan", map[string]interface{}{
+			"id":          plan.Id.ValueString(),
+			"not_started": notStarted,
+			"concurrency": plan.Concurrency.ValueInt64(),
+		})
+		resp.Diagnostics.Append(r.runRestores(ctx, items, int(plan.Concurrency.ValueInt64()), plan.WaitForCompletion.ValueBool())...)
+		resp.Diagnostics.Append(setRestorePlanRestores(ctx, &plan, items)...)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// ModifyPlan plans an update while some restores haven't started, so the next apply starts them
+func (r *RestorePlanResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state RestorePlanResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	items, diags := restorePlanItems(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || countRestorePlanStatus(items, restorePlanStatusNotStarted) == 0 {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status_counts"), types.MapUnknown(types.Int64Type))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("restores"), types.ListUnknown(types.ObjectType{AttrTypes: restorePlanRestoreAttrTypes}))...)
+}
+
+// countRestorePlanStatus returns the number of restores with the given status
+func countRestorePlanStatus(items []*restorePlanItem, status string) int {
+	count := 0
+	for _, item := range items {
+		if item.model.Status.ValueString() == status {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *RestorePlanResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RestorePlanResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The Eon API has no endpoint for cancelling restore jobs, so warn when destroy leaves some running.
+	if data.Status.ValueString() == restorePlanStatusRunning {
+		resp.Diagnostics.AddWarning(
+			"Restore Plan Still Running",
+			fmt.Sprintf("Restore plan %s still has running restore jobs. Removing it from Terraform state doesn't stop them, because the Eon API doesn't support cancelling restore jobs. Cancel them from the Eon console if they're no longer needed.", data.Id.ValueString()),
+		)
+	}
+
+	tflog.Debug(ctx, "Restore plan removed from state", map[string]interface{}{"id": data.Id.ValueString()})
+}
@@ -0,0 +1,248 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/eon-io/terraform-provider-eon/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestRestorePlanResourceSchema tests that the restore plan schema builds with the shared resource selector
+func TestRestorePlanResourceSchema(t *testing.T) {
+	t.Parallel()
+
+	resp := &resource.SchemaResponse{}
+	NewRestorePlanResource().Schema(context.Background(), resource.SchemaRequest{}, resp)
+	require.False(t, resp.Diagnostics.HasError())
+
+	selector, ok := resp.Schema.Attributes["resource_selector"]
+	require.True(t, ok)
+	assert.True(t, selector.IsOptional())
+	assert.False(t, selector.IsRequired())
+
+	concurrency := resp.Schema.Attributes["concurrency"].(schema.Int64Attribute)
+	assert.NotEmpty(t, concurrency.Validators)
+
+	// Computed restore details keep their state values on in-place updates
+	assert.NotEmpty(t, resp.Schema.Attributes["status"].(schema.StringAttribute).PlanModifiers)
+	assert.NotEmpty(t, resp.Schema.Attributes["status_counts"].(schema.MapAttribute).PlanModifiers)
+	assert.NotEmpty(t, resp.Schema.Attributes["restores"].(schema.ListNestedAttribute).PlanModifiers)
+}
+
+// TestRestorePlanStatus tests aggregating restore statuses into the plan status
+func TestRestorePlanStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		statuses []string
+		expected string
+	}{
+		{"all completed", []string{"JOB_COMPLETED", "JOB_COMPLETED"}, restorePlanStatusCompleted},
+		{"running", []string{"JOB_COMPLETED", "JOB_RUNNING"}, restorePlanStatusRunning},
+		{"pending", []string{"JOB_PENDING", "JOB_FAILED"}, restorePlanStatusRunning},
+		{"not started", []string{restorePlanStatusNotStarted}, restorePlanStatusRunning},
+		{"all failed", []string{"JOB_FAILED", restorePlanStatusStartFailed, "JOB_CANCELLED"}, restorePlanStatusFailed},
+		{"some failed", []string{"JOB_COMPLETED", "JOB_FAILED"}, restorePlanStatusPartial},
+		{"partial job", []string{"JOB_PARTIAL"}, restorePlanStatusPartial},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, restorePlanStatus(tt.statuses))
+		})
+	}
+}
+
+// TestMissingRestoreDefaults tests reporting selected resources whose type has no settings block
+func TestMissingRestoreDefaults(t *testing.T) {
+	t.Parallel()
+
+	resources := []externalEonSdkAPI.InventoryResource{
+		{Id: "ec2-1", ResourceType: externalEonSdkAPI.AWS_EC2},
+		{Id: "rds-1", ResourceType: externalEonSdkAPI.AWS_RDS},
+		{Id: "rds-2", ResourceType: externalEonSdkAPI.AWS_RDS},
+		{Id: "blob-1", ResourceType: externalEonSdkAPI.AZURE_STORAGE_ACCOUNT},
+	}
+
+	missing := missingRestoreDefaults(RestorePlanResourceModel{Ec2Defaults: &Ec2RestoreDefaults{}}, resources)
+	assert.Equal(t, []string{
+		"AWS_RDS (rds_defaults is required): rds-1, rds-2",
+		"AZURE_STORAGE_ACCOUNT (restore plans don't support this resource type): blob-1",
+	}, missing)
+
+	assert.Empty(t, missingRestoreDefaults(RestorePlanResourceModel{
+		Ec2Defaults: &Ec2RestoreDefaults{},
+		RdsDefaults: &RdsRestoreDefaults{},
+	}, resources[:3]))
+}
+
+// TestRestorePlanVolumeParams tests restoring every snapshot volume at its original size
+func TestRestorePlanVolumeParams(t *testing.T) {
+	t.Parallel()
+
+	iops := int32(3000)
+	ec2Properties := externalEonSdkAPI.NewAwsEc2SnapshotProperties()
+	ec2Properties.SetVolumes([]externalEonSdkAPI.InventorySnapshotVolume{
+		{ProviderVolumeId: "vol-root", VolumeSettings: externalEonSdkAPI.VolumeSettings{Type: "gp3", SizeBytes: 20 << 30, Iops: &iops}},
+		{ProviderVolumeId: "vol-data", VolumeSettings: externalEonSdkAPI.VolumeSettings{Type: "io2", SizeBytes: 1 << 40, Iops: &iops}},
+	})
+	properties := externalEonSdkAPI.NewResourceSnapshotProperties()
+	properties.SetAwsEc2(*ec2Properties)
+	resourceSnapshot := externalEonSdkAPI.NewResourceSnapshot()
+	resourceSnapshot.SetProperties(*properties)
+	snapshot := &externalEonSdkAPI.Snapshot{Id: "snapshot-1"}
+	snapshot.SetResource(*resourceSnapshot)
+
+	params := restorePlanVolumeParams(snapshot, &Ec2RestoreDefaults{
+		VolumeType: types.StringValue("gp3"),
+		KmsKeyId:   types.StringValue("alias/aws/ebs"),
+	})
+	require.Len(t, params, 2)
+
+	assert.Equal(t, "vol-root", params[0].ProviderVolumeId.ValueString())
+	assert.Equal(t, "20GiB", params[0].VolumeSize.ValueString())
+	assert.Equal(t, int64(3000), params[0].Iops.ValueInt64())
+	assert.Equal(t, "alias/aws/ebs", params[0].KmsKeyId.ValueString())
+
+	// Changing the volume type drops the source IOPS, which may not be valid for the new type
+	assert.Equal(t, "gp3", params[1].VolumeType.ValueString())
+	assert.Equal(t, "1TiB", params[1].VolumeSize.ValueString())
+	assert.True(t, params[1].Iops.IsNull())
+
+	_, diags := types.ListValueFrom(context.Background(), types.ObjectType{AttrTypes: volumeRestoreParamAttrTypes}, params)
+	assert.False(t, diags.HasError())
+}
+
+// TestRestoredNameSuffix tests the default suffix for restored database and table names
+func TestRestoredNameSuffix(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "-restored", restoredNameSuffix(types.StringNull()))
+	assert.Equal(t, "-drill", restoredNameSuffix(types.StringValue("-drill")))
+	assert.Equal(t, "", restoredNameSuffix(types.StringValue("")))
+
+	assert.Equal(t, "orders", restoredResourceName(&externalEonSdkAPI.InventoryResource{ResourceName: "orders", ProviderResourceId: "arn:orders"}))
+	assert.Equal(t, "arn:orders", restoredResourceName(&externalEonSdkAPI.InventoryResource{ProviderResourceId: "arn:orders"}))
+}
+
+// TestRestorePlanModifyPlan tests that restores which haven't started yet plan an update that starts them
+func TestRestorePlanModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	NewRestorePlanResource().Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, nil)
+	}
+
+	stateWithStatuses := func(statuses ...string) tfsdk.State {
+		models := make([]RestorePlanRestoreModel, 0, len(statuses))
+		for i, status := range statuses {
+			models = append(models, RestorePlanRestoreModel{
+				ResourceId:        types.StringValue(fmt.Sprintf("resource-%d", i)),
+				ResourceName:      types.StringNull(),
+				ResourceType:      types.StringValue("AWS_RDS"),
+				SnapshotId:        types.StringValue(fmt.Sprintf("snapshot-%d", i)),
+				RecoveryPointTime: types.StringNull(),
+				JobId:             types.StringNull(),
+				Status:            types.StringValue(status),
+				StatusMessage:     types.StringNull(),
+				RestoredResources: types.ListValueMust(types.ObjectType{AttrTypes: restoredResourceAttrTypes}, []attr.Value{}),
+			})
+		}
+		restores, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: restorePlanRestoreAttrTypes}, models)
+		require.False(t, diags.HasError())
+
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+		require.False(t, state.SetAttribute(ctx, path.Root("restores"), restores).HasError())
+		require.False(t, state.SetAttribute(ctx, path.Root("status"), types.StringValue(restorePlanStatus(statuses))).HasError())
+		return state
+	}
+
+	tests := []struct {
+		name          string
+		state         tfsdk.State
+		plansRestores bool
+	}{
+		{"not started", stateWithStatuses("JOB_COMPLETED", restorePlanStatusNotStarted), true},
+		{"all started", stateWithStatuses("JOB_COMPLETED", "JOB_RUNNING", restorePlanStatusStartFailed), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &resource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: tt.state.Schema, Raw: tt.state.Raw}}
+			NewRestorePlanResource().(*RestorePlanResource).ModifyPlan(ctx, resource.ModifyPlanRequest{
+				State: tt.state,
+				Plan:  resp.Plan,
+			}, resp)
+			require.False(t, resp.Diagnostics.HasError())
+
+			var restores types.List
+			require.False(t, resp.Plan.GetAttribute(ctx, path.Root("restores"), &restores).HasError())
+			assert.Equal(t, tt.plansRestores, restores.IsUnknown())
+
+			var status types.String
+			require.False(t, resp.Plan.GetAttribute(ctx, path.Root("status"), &status).HasError())
+			assert.Equal(t, tt.plansRestores, status.IsUnknown())
+		})
+	}
+}
+
+// TestRunRestoresTimeout tests that restores that haven't started when the timeout expires stay NOT_STARTED
+func TestRunRestoresTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	item := &restorePlanItem{model: RestorePlanRestoreModel{Status: types.StringValue(restorePlanStatusNotStarted)}}
+	r := &RestorePlanResource{client: &client.EonClient{}}
+	diags := r.runRestores(ctx, []*restorePlanItem{item}, 1, true)
+
+	assert.False(t, diags.HasError())
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "Restore Plan Not Fully Started", diags.Warnings()[0].Summary())
+	assert.Equal(t, restorePlanStatusNotStarted, item.model.Status.ValueString())
+}
+
+// TestRunRestoresResumeTimeout tests that resuming the wait for a restore plan doesn't start restores, and counts
+// the restores that didn't finish against the whole plan
+func TestRunRestoresResumeTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	notStarted := &restorePlanItem{model: RestorePlanRestoreModel{Status: types.StringValue(restorePlanStatusNotStarted)}}
+	items := []*restorePlanItem{
+		{model: RestorePlanRestoreModel{JobId: types.StringValue("job-1"), Status: types.StringValue("JOB_RUNNING")}},
+		{model: RestorePlanRestoreModel{JobId: types.StringValue("job-2"), Status: types.StringValue("JOB_COMPLETED")}},
+		notStarted,
+	}
+	r := &RestorePlanResource{client: &client.EonClient{PollConfig: client.PollConfig{Interval: time.Hour}}}
+	diags := r.runRestores(ctx, items, 0, true)
+
+	require.Len(t, diags.Warnings(), 1)
+	assert.Equal(t, "Restore Plan Still Running", diags.Warnings()[0].Summary())
+	assert.Contains(t, diags.Warnings()[0].Detail(), "1 of 3 restores")
+	assert.Equal(t, restorePlanStatusNotStarted, notStarted.model.Status.ValueString())
+}
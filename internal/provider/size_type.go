@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable                    = SizeType{}
+	_ basetypes.StringValuableWithSemanticEquals = SizeValue{}
+	_ xattr.ValidateableAttribute                = SizeValue{}
+	_ validator.String                           = sizeUnitRequiredValidator{}
+)
+
+// sizeUnits maps the accepted size units to their size in bytes. Values without a unit are in GiB.
+var sizeUnits = map[string]int64{
+	"b":   1,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+var sizePattern = regexp.MustCompile(`^\s*(\d+)\s*([A-Za-z]*)\s*$`)
+
+// sizeDescription documents the size format in attribute descriptions
+const sizeDescription = "Accepts a number with a unit of `B`, `KiB`, `MiB`, `GiB` or `TiB`, such as `\"100GiB\"` or `\"1TiB\"`. A number without a unit is in GiB."
+
+// ebsVolumeSizeDescription documents the size format of EBS volume sizes, which used to be in bytes without a unit
+const ebsVolumeSizeDescription = "Accepts a number with a unit of `B`, `KiB`, `MiB`, `GiB` or `TiB`, such as `\"100GiB\"` or `\"1TiB\"`. The unit is required, since a number without a unit was in bytes before schema version 1."
+
+// SizeType is a string type for storage sizes such as "100GiB" or "1TiB"
+type SizeType struct {
+	basetypes.StringType
+}
+
+func (t SizeType) Equal(o attr.Type) bool {
+	other, ok := o.(SizeType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SizeType) String() string {
+	return "SizeType"
+}
+
+func (t SizeType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SizeValue{StringValue: in}, nil
+}
+
+func (t SizeType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t SizeType) ValueType(ctx context.Context) attr.Value {
+	return SizeValue{}
+}
+
+// SizeValue is a storage size such as "100GiB" or "1TiB"
+type SizeValue struct {
+	basetypes.StringValue
+}
+
+// NewSizeNull returns a null size
+func NewSizeNull() SizeValue {
+	return SizeValue{StringValue: basetypes.NewStringNull()}
+}
+
+// NewSizeValue returns a known size
+func NewSizeValue(value string) SizeValue {
+	return SizeValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v SizeValue) Equal(o attr.Value) bool {
+	other, ok := o.(SizeValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v SizeValue) Type(ctx context.Context) attr.Type {
+	return SizeType{}
+}
+
+// StringSemanticEquals treats sizes that are the same number of bytes as equal, so "1TiB" and "1024GiB"
+// don't cause a diff
+func (v SizeValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SizeValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got value type %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	priorBytes, err := parseSize(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+	newBytes, err := parseSize(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return priorBytes == newBytes, diags
+}
+
+func (v SizeValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := parseSize(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid Size", fmt.Sprintf("%s. %s", err, sizeDescription))
+	}
+}
+
+// Bytes returns the size in bytes
+func (v SizeValue) Bytes() (int64, error) {
+	return parseSize(v.ValueString())
+}
+
+// parseSize converts a size such as "100GiB" to bytes. A size without a unit is in GiB.
+func parseSize(value string) (int64, error) {
+	matches := sizePattern.FindStringSubmatch(value)
+	if matches == nil {
+		return 0, fmt.Errorf("invalid size %q", value)
+	}
+
+	number, err := strconv.ParseInt(matches[1], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q: %w", value, err)
+	}
+
+	unit := strings.ToLower(matches[2])
+	if unit == "" {
+		unit = "gib"
+	}
+	multiplier, ok := sizeUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("invalid size %q: unknown unit %q", value, matches[2])
+	}
+
+	if number > math.MaxInt64/multiplier {
+		return 0, fmt.Errorf("invalid size %q: too large", value)
+	}
+
+	return number * multiplier, nil
+}
+
+// sizeUnitRequired returns a validator that rejects sizes without a unit. It's used by sizes that were in bytes
+// before schema version 1, so existing configurations fail clearly instead of being read as GiB.
+func sizeUnitRequired() validator.String {
+	return sizeUnitRequiredValidator{}
+}
+
+type sizeUnitRequiredValidator struct{}
+
+func (v sizeUnitRequiredValidator) Description(ctx context.Context) string {
+	return "size must include a unit"
+}
+
+func (v sizeUnitRequiredValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v sizeUnitRequiredValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	matches := sizePattern.FindStringSubmatch(req.ConfigValue.ValueString())
+	if matches == nil || matches[2] != "" {
+		// Invalid sizes are reported by the size type's validation
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Size Unit Required",
+		fmt.Sprintf("Size %q has no unit. A number without a unit used to be in bytes, so add the unit you mean, such as %q or %q.",
+			req.ConfigValue.ValueString(), matches[1]+"B", matches[1]+"GiB"),
+	)
+}
+
+// formatSize formats a number of bytes with the largest unit that divides it evenly
+func formatSize(bytes int64) string {
+	for _, unit := range []string{"TiB", "GiB", "MiB", "KiB"} {
+		multiplier := sizeUnits[strings.ToLower(unit)]
+		if bytes >= multiplier && bytes%multiplier == 0 {
+			return fmt.Sprintf("%d%s", bytes/multiplier, unit)
+		}
+	}
+	return fmt.Sprintf("%dB", bytes)
+}
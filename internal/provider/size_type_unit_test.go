@@ -0,0 +1,128 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParseSize tests converting sizes with and without units to bytes
+func TestParseSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value    string
+		expected int64
+		wantErr  bool
+	}{
+		{value: "100", expected: 100 << 30},
+		{value: "100GiB", expected: 100 << 30},
+		{value: "100gib", expected: 100 << 30},
+		{value: "1TiB", expected: 1 << 40},
+		{value: "512MiB", expected: 512 << 20},
+		{value: "4KiB", expected: 4 << 10},
+		{value: "1024B", expected: 1024},
+		{value: " 8 GiB ", expected: 8 << 30},
+		{value: "", wantErr: true},
+		{value: "100GB", wantErr: true},
+		{value: "-1GiB", wantErr: true},
+		{value: "1.5TiB", wantErr: true},
+		{value: "99999999999TiB", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			t.Parallel()
+
+			bytes, err := parseSize(tt.value)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, bytes)
+		})
+	}
+}
+
+// TestFormatSize tests formatting byte counts with the largest even unit
+func TestFormatSize(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0B", formatSize(0))
+	assert.Equal(t, "1000B", formatSize(1000))
+	assert.Equal(t, "4KiB", formatSize(4<<10))
+	assert.Equal(t, "1536MiB", formatSize(1536<<20))
+	assert.Equal(t, "100GiB", formatSize(100<<30))
+	assert.Equal(t, "2TiB", formatSize(2<<40))
+}
+
+// TestSizeValueSemanticEquals tests that sizes of the same number of bytes are equal
+func TestSizeValueSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	equal, diags := NewSizeValue("1TiB").StringSemanticEquals(ctx, NewSizeValue("1024GiB"))
+	require.False(t, diags.HasError())
+	assert.True(t, equal)
+
+	equal, diags = NewSizeValue("100").StringSemanticEquals(ctx, NewSizeValue("100GiB"))
+	require.False(t, diags.HasError())
+	assert.True(t, equal)
+
+	equal, diags = NewSizeValue("100GiB").StringSemanticEquals(ctx, NewSizeValue("200GiB"))
+	require.False(t, diags.HasError())
+	assert.False(t, equal)
+}
+
+// TestSizeValueValidateAttribute tests that invalid sizes are rejected
+func TestSizeValueValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	req := xattr.ValidateAttributeRequest{Path: path.Root("volume_size")}
+
+	resp := &xattr.ValidateAttributeResponse{}
+	NewSizeValue("100GiB").ValidateAttribute(ctx, req, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	resp = &xattr.ValidateAttributeResponse{}
+	NewSizeNull().ValidateAttribute(ctx, req, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+
+	resp = &xattr.ValidateAttributeResponse{}
+	NewSizeValue("100GB").ValidateAttribute(ctx, req, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+// TestSizeUnitRequired tests that sizes which used to be in bytes must include a unit
+func TestSizeUnitRequired(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	tests := []struct {
+		value   types.String
+		wantErr bool
+	}{
+		{types.StringValue("1073741824"), true},
+		{types.StringValue("1073741824B"), false},
+		{types.StringValue("100GiB"), false},
+		{types.StringValue("100GB"), false},
+		{types.StringNull(), false},
+		{types.StringUnknown(), false},
+	}
+
+	for _, tt := range tests {
+		resp := &validator.StringResponse{}
+		sizeUnitRequired().ValidateString(ctx, validator.StringRequest{Path: path.Root("volume_size"), ConfigValue: tt.value}, resp)
+		assert.Equal(t, tt.wantErr, resp.Diagnostics.HasError(), "%s", tt.value)
+	}
+}
@@ -0,0 +1,689 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var _ resource.Resource = &TypedRestoreResource{}
+var _ resource.ResourceWithImportState = &TypedRestoreResource{}
+var _ resource.ResourceWithModifyPlan = &TypedRestoreResource{}
+var _ resource.ResourceWithMoveState = &TypedRestoreResource{}
+
+func NewEbsVolumeRestoreResource() resource.Resource {
+	return &TypedRestoreResource{kind: ebsVolumeRestoreKind}
+}
+
+func NewEc2InstanceRestoreResource() resource.Resource {
+	return &TypedRestoreResource{kind: ec2InstanceRestoreKind}
+}
+
+func NewRdsInstanceRestoreResource() resource.Resource {
+	return &TypedRestoreResource{kind: rdsInstanceRestoreKind}
+}
+
+func NewS3BucketRestoreResource() resource.Resource {
+	return &TypedRestoreResource{kind: s3BucketRestoreKind}
+}
+
+func NewS3FilesRestoreResource() resource.Resource {
+	return &TypedRestoreResource{kind: s3FilesRestoreKind}
+}
+
+// TypedRestoreResource restores one type of resource. It has the attributes of one eon_restore_job configuration
+// block at the top level, and shares eon_restore_job's validation and job handling.
+type TypedRestoreResource struct {
+	job  RestoreJobResource
+	kind restoreKind
+}
+
+// restoreKind describes a type-specific restore resource
+type restoreKind struct {
+	typeNameSuffix string
+	description    string
+	resourceType   externalEonSdkAPI.ResourceType
+	restoreType    string
+	// configBlock is the eon_restore_job block whose attributes the resource has
+	configBlock string
+	// configAttributes lists the configBlock attributes the resource has. Nil means all of them.
+	configAttributes []string
+	// configDescriptions replaces the descriptions of configBlock attributes
+	configDescriptions map[string]string
+	// restoredAttributes lists the type-specific eon_restore_job attributes describing the restored resource
+	restoredAttributes []string
+	newModel           func() restoreModel
+}
+
+// restoreModel is implemented by the models of the type-specific restore resources
+type restoreModel interface {
+	// restoreJob returns the equivalent eon_restore_job model
+	restoreJob() RestoreJobResourceModel
+	// setRestoreJob sets the model from an eon_restore_job model
+	setRestoreJob(job RestoreJobResourceModel)
+}
+
+var ebsVolumeRestoreKind = restoreKind{
+	typeNameSuffix: "_ebs_volume_restore",
+	description:    "Restores an EBS volume from an Eon snapshot of an AWS EC2 instance.",
+	resourceType:   externalEonSdkAPI.AWS_EC2,
+	restoreType:    "partial",
+	configBlock:    "ebs_config",
+	configDescriptions: map[string]string{
+		"volume_size": "Size of the restored volume. Must be at least the size of the source volume. " + ebsVolumeSizeDescription,
+	},
+	restoredAttributes: []string{"restored_volume_id"},
+	newModel:           func() restoreModel { return &EbsVolumeRestoreResourceModel{} },
+}
+
+var ec2InstanceRestoreKind = restoreKind{
+	typeNameSuffix:     "_ec2_instance_restore",
+	description:        "Restores an AWS EC2 instance and the volumes attached to it from an Eon snapshot.",
+	resourceType:       externalEonSdkAPI.AWS_EC2,
+	restoreType:        "full",
+	configBlock:        "ec2_config",
+	restoredAttributes: []string{"restored_instance_id"},
+	newModel:           func() restoreModel { return &Ec2InstanceRestoreResourceModel{} },
+}
+
+var rdsInstanceRestoreKind = restoreKind{
+	typeNameSuffix: "_rds_instance_restore",
+	description:    "Restores an AWS RDS database instance from an Eon snapshot.",
+	resourceType:   externalEonSdkAPI.AWS_RDS,
+	restoreType:    "full",
+	configBlock:    "rds_config",
+	// The rds_config attributes the Eon API doesn't accept are left out
+	configAttributes: []string{"db_instance_identifier", "region", "subnet_group_name", "vpc_security_group_ids", "kms_key_id", "tags"},
+	newModel:         func() restoreModel { return &RdsInstanceRestoreResourceModel{} },
+}
+
+var s3BucketRestoreKind = restoreKind{
+	typeNameSuffix: "_s3_bucket_restore",
+	description:    "Restores the contents of an AWS S3 bucket from an Eon snapshot to an existing bucket.",
+	resourceType:   externalEonSdkAPI.AWS_S3,
+	restoreType:    "full",
+	configBlock:    "s3_bucket_config",
+	newModel:       func() restoreModel { return &S3BucketRestoreResourceModel{} },
+}
+
+var s3FilesRestoreKind = restoreKind{
+	typeNameSuffix: "_s3_files_restore",
+	description:    "Restores selected files and directories of an AWS S3 bucket from an Eon snapshot to an existing bucket.",
+	resourceType:   externalEonSdkAPI.AWS_S3,
+	restoreType:    "partial",
+	configBlock:    "s3_file_config",
+	newModel:       func() restoreModel { return &S3FilesRestoreResourceModel{} },
+}
+
+// restoreResourceAttributes lists the eon_restore_job attributes every type-specific restore resource has
+var restoreResourceAttributes = []string{
+	"id",
+	"snapshot_id",
+	"point_in_time",
+	"resource_id",
+	"restore_account_id",
+	"triggers",
+	"wait_for_completion",
+	"job_id",
+	"status",
+	"status_message",
+	"created_at",
+	"started_at",
+	"completed_at",
+	"duration_seconds",
+	"recovery_point_time",
+	"restored_resources",
+}
+
+// RestoreResourceModel holds the attributes every type-specific restore resource has
+type RestoreResourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	SnapshotId        types.String   `tfsdk:"snapshot_id"`
+	ResourceId        types.String   `tfsdk:"resource_id"`
+	PointInTime       types.String   `tfsdk:"point_in_time"`
+	RestoreAccountId  types.String   `tfsdk:"restore_account_id"`
+	Triggers          types.Map      `tfsdk:"triggers"`
+	WaitForCompletion types.Bool     `tfsdk:"wait_for_completion"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+
+	// Job status fields (computed)
+	JobId             types.String `tfsdk:"job_id"`
+	Status            types.String `tfsdk:"status"`
+	StatusMessage     types.String `tfsdk:"status_message"`
+	CreatedAt         types.String `tfsdk:"created_at"`
+	StartedAt         types.String `tfsdk:"started_at"`
+	CompletedAt       types.String `tfsdk:"completed_at"`
+	DurationSeconds   types.Int64  `tfsdk:"duration_seconds"`
+	RecoveryPointTime types.String `tfsdk:"recovery_point_time"`
+	RestoredResources types.List   `tfsdk:"restored_resources"`
+}
+
+// toRestoreJob returns an eon_restore_job model with the shared attributes and no configuration block
+func (m RestoreResourceModel) toRestoreJob(restoreType string) RestoreJobResourceModel {
+	return RestoreJobResourceModel{
+		Id:                 m.Id,
+		RestoreType:        types.StringValue(restoreType),
+		SnapshotId:         m.SnapshotId,
+		ResourceId:         m.ResourceId,
+		PointInTime:        m.PointInTime,
+		RestoreAccountId:   m.RestoreAccountId,
+		Triggers:           m.Triggers,
+		TimeoutMinutes:     types.Int64Null(),
+		WaitForCompletion:  m.WaitForCompletion,
+		Timeouts:           m.Timeouts,
+		JobId:              m.JobId,
+		Status:             m.Status,
+		StatusMessage:      m.StatusMessage,
+		CreatedAt:          m.CreatedAt,
+		StartedAt:          m.StartedAt,
+		CompletedAt:        m.CompletedAt,
+		DurationSeconds:    m.DurationSeconds,
+		RecoveryPointTime:  m.RecoveryPointTime,
+		RestoredResources:  m.RestoredResources,
+		RestoredVolumeId:   types.StringNull(),
+		RestoredInstanceId: types.StringNull(),
+		RestoredDiskId:     types.StringNull(),
+	}
+}
+
+// fromRestoreJob sets the shared attributes from an eon_restore_job model
+func (m *RestoreResourceModel) fromRestoreJob(job RestoreJobResourceModel) {
+	m.Id = job.Id
+	m.SnapshotId = job.SnapshotId
+	m.ResourceId = job.ResourceId
+	m.PointInTime = job.PointInTime
+	m.RestoreAccountId = job.RestoreAccountId
+	m.Triggers = job.Triggers
+	m.WaitForCompletion = job.WaitForCompletion
+	m.Timeouts = job.Timeouts
+	m.JobId = job.JobId
+	m.Status = job.Status
+	m.StatusMessage = job.StatusMessage
+	m.CreatedAt = job.CreatedAt
+	m.StartedAt = job.StartedAt
+	m.CompletedAt = job.CompletedAt
+	m.DurationSeconds = job.DurationSeconds
+	m.RecoveryPointTime = job.RecoveryPointTime
+	m.RestoredResources = job.RestoredResources
+}
+
+type EbsVolumeRestoreResourceModel struct {
+	RestoreResourceModel
+	EbsRestoreConfig
+	RestoredVolumeId types.String `tfsdk:"restored_volume_id"`
+}
+
+func (m *EbsVolumeRestoreResourceModel) restoreJob() RestoreJobResourceModel {
+	job := m.toRestoreJob(ebsVolumeRestoreKind.restoreType)
+	config := m.EbsRestoreConfig
+	job.EbsConfig = &config
+	job.RestoredVolumeId = m.RestoredVolumeId
+	return job
+}
+
+func (m *EbsVolumeRestoreResourceModel) setRestoreJob(job RestoreJobResourceModel) {
+	m.fromRestoreJob(job)
+	if job.EbsConfig != nil {
+		m.EbsRestoreConfig = *job.EbsConfig
+	}
+	m.RestoredVolumeId = job.RestoredVolumeId
+}
+
+type Ec2InstanceRestoreResourceModel struct {
+	RestoreResourceModel
+	Ec2RestoreConfig
+	RestoredInstanceId types.String `tfsdk:"restored_instance_id"`
+}
+
+func (m *Ec2InstanceRestoreResourceModel) restoreJob() RestoreJobResourceModel {
+	job := m.toRestoreJob(ec2InstanceRestoreKind.restoreType)
+	config := m.Ec2RestoreConfig
+	job.Ec2Config = &config
+	job.RestoredInstanceId = m.RestoredInstanceId
+	return job
+}
+
+func (m *Ec2InstanceRestoreResourceModel) setRestoreJob(job RestoreJobResourceModel) {
+	m.fromRestoreJob(job)
+	if job.Ec2Config != nil {
+		m.Ec2RestoreConfig = *job.Ec2Config
+	}
+	m.RestoredInstanceId = job.RestoredInstanceId
+}
+
+type RdsInstanceRestoreResourceModel struct {
+	RestoreResourceModel
+	DbInstanceIdentifier types.String `tfsdk:"db_instance_identifier"`
+	Region               types.String `tfsdk:"region"`
+	SubnetGroupName      types.String `tfsdk:"subnet_group_name"`
+	VpcSecurityGroupIds  types.List   `tfsdk:"vpc_security_group_ids"`
+	KmsKeyId             types.String `tfsdk:"kms_key_id"`
+	Tags                 types.Map    `tfsdk:"tags"`
+}
+
+func (m *RdsInstanceRestoreResourceModel) restoreJob() RestoreJobResourceModel {
+	job := m.toRestoreJob(rdsInstanceRestoreKind.restoreType)
+	// The rds_config attributes the Eon API doesn't accept are left null
+	job.RdsConfig = &RdsRestoreConfig{
+		DbInstanceIdentifier: m.DbInstanceIdentifier,
+		Region:               m.Region,
+		SubnetGroupName:      m.SubnetGroupName,
+		VpcSecurityGroupIds:  m.VpcSecurityGroupIds,
+		KmsKeyId:             m.KmsKeyId,
+		Tags:                 m.Tags,
+	}
+	return job
+}
+
+func (m *RdsInstanceRestoreResourceModel) setRestoreJob(job RestoreJobResourceModel) {
+	m.fromRestoreJob(job)
+	if job.RdsConfig != nil {
+		m.DbInstanceIdentifier = job.RdsConfig.DbInstanceIdentifier
+		m.Region = job.RdsConfig.Region
+		m.SubnetGroupName = job.RdsConfig.SubnetGroupName
+		m.VpcSecurityGroupIds = job.RdsConfig.VpcSecurityGroupIds
+		m.KmsKeyId = job.RdsConfig.KmsKeyId
+		m.Tags = job.RdsConfig.Tags
+	}
+}
+
+type S3BucketRestoreResourceModel struct {
+	RestoreResourceModel
+	S3BucketRestoreConfig
+}
+
+func (m *S3BucketRestoreResourceModel) restoreJob() RestoreJobResourceModel {
+	job := m.toRestoreJob(s3BucketRestoreKind.restoreType)
+	config := m.S3BucketRestoreConfig
+	job.S3BucketConfig = &config
+	return job
+}
+
+func (m *S3BucketRestoreResourceModel) setRestoreJob(job RestoreJobResourceModel) {
+	m.fromRestoreJob(job)
+	if job.S3BucketConfig != nil {
+		m.S3BucketRestoreConfig = *job.S3BucketConfig
+	}
+}
+
+type S3FilesRestoreResourceModel struct {
+	RestoreResourceModel
+	S3FileRestoreConfig
+}
+
+func (m *S3FilesRestoreResourceModel) restoreJob() RestoreJobResourceModel {
+	job := m.toRestoreJob(s3FilesRestoreKind.restoreType)
+	config := m.S3FileRestoreConfig
+	job.S3FileConfig = &config
+	return job
+}
+
+func (m *S3FilesRestoreResourceModel) setRestoreJob(job RestoreJobResourceModel) {
+	m.fromRestoreJob(job)
+	if job.S3FileConfig != nil {
+		m.S3FileRestoreConfig = *job.S3FileConfig
+	}
+}
+
+func (r *TypedRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + r.kind.typeNameSuffix
+}
+
+func (r *TypedRestoreResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	jobSchema := restoreJobSchema(ctx)
+
+	attributes := map[string]schema.Attribute{}
+	for _, name := range append(slices.Clone(restoreResourceAttributes), r.kind.restoredAttributes...) {
+		attributes[name] = jobSchema.Attributes[name]
+	}
+
+	// The configuration block's attributes move to the top level. The block required replacement as a whole,
+	// so each attribute now does, with the same exception for inputs that import couldn't read.
+	config := jobSchema.Blocks[r.kind.configBlock].(schema.SingleNestedBlock)
+	for name, attribute := range config.Attributes {
+		if r.kind.configAttributes != nil && !slices.Contains(r.kind.configAttributes, name) {
+			continue
+		}
+		attributes[name] = requiresReplaceAttribute(attribute, r.kind.configDescriptions[name])
+	}
+
+	blocks := map[string]schema.Block{
+		"timeouts": timeouts.BlockAll(ctx),
+	}
+	for name, block := range config.Blocks {
+		if listBlock, ok := block.(schema.ListNestedBlock); ok {
+			listBlock.PlanModifiers = append(listBlock.PlanModifiers, restoreListInputRequiresReplace())
+			block = listBlock
+		}
+		blocks[name] = block
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: r.kind.description + " This operation is asynchronous and returns a job ID that can be used to track the progress of the restore job.\n\n" +
+			"Changing any restore input or `triggers` starts a new restore job. Only `wait_for_completion` and `timeouts` can change in place.\n\n" +
+			"Destroying this resource only removes it from Terraform state. It doesn't cancel a running job or delete restored resources, because the Eon API doesn't support cancelling restore jobs. If the job is still running when it's destroyed, the provider shows a warning so you can cancel it from the Eon console.\n\n" +
+			"An `eon_restore_job` that uses `" + r.kind.configBlock + "` can be moved to this resource type with a `moved` block, without starting a new restore job.\n\n" +
+			"Restore jobs started outside Terraform can be imported by job ID. Import reads the snapshot, resource, restore account and target region from Eon. The Eon API doesn't report the other target settings, so add them to the configuration. The first apply after import records them in state without starting a new restore job.",
+		Attributes: attributes,
+		Blocks:     blocks,
+	}
+}
+
+// restoreJobSchema returns the eon_restore_job schema
+func restoreJobSchema(ctx context.Context) schema.Schema {
+	var resp resource.SchemaResponse
+	(&RestoreJobResource{}).Schema(ctx, resource.SchemaRequest{}, &resp)
+	return resp.Schema
+}
+
+// requiresReplaceAttribute returns a copy of a restore configuration attribute that requires replacement when it
+// changes, unless the restore was imported and the attribute wasn't known, with its description replaced if description isn't empty
+func requiresReplaceAttribute(attribute schema.Attribute, description string) schema.Attribute {
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		a.PlanModifiers = append(slices.Clone(a.PlanModifiers), restoreInputRequiresReplace())
+		if description != "" {
+			a.MarkdownDescription = description
+		}
+		return a
+	case schema.Int64Attribute:
+		a.PlanModifiers = append(slices.Clone(a.PlanModifiers), restoreInt64InputRequiresReplace())
+		if description != "" {
+			a.MarkdownDescription = description
+		}
+		return a
+	case schema.BoolAttribute:
+		a.PlanModifiers = append(slices.Clone(a.PlanModifiers), restoreBoolInputRequiresReplace())
+		if description != "" {
+			a.MarkdownDescription = description
+		}
+		return a
+	case schema.ListAttribute:
+		a.PlanModifiers = append(slices.Clone(a.PlanModifiers), restoreListInputRequiresReplace())
+		if description != "" {
+			a.MarkdownDescription = description
+		}
+		return a
+	case schema.MapAttribute:
+		a.PlanModifiers = append(slices.Clone(a.PlanModifiers), restoreMapInputRequiresReplace())
+		if description != "" {
+			a.MarkdownDescription = description
+		}
+		return a
+	default:
+		return attribute
+	}
+}
+
+func (r *TypedRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.job.Configure(ctx, req, resp)
+}
+
+// layout keeps the restore configuration at the top level, and only accepts resources of the kind's type
+func (r *TypedRestoreResource) layout() restoreLayout {
+	return restoreLayout{
+		configPath:     func(string) path.Path { return path.Empty() },
+		validateConfig: r.kind.validateResourceType,
+	}
+}
+
+// validateResourceType checks that the resource being restored is of the type the kind restores
+func (k restoreKind) validateResourceType(data RestoreJobResourceModel, resourceType externalEonSdkAPI.ResourceType) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if resourceType != k.resourceType {
+		diags.AddAttributeError(path.Root("resource_id"), "Configuration Error", fmt.Sprintf("eon%s can only restore %s resources, but resource %s is a %s resource", k.typeNameSuffix, k.resourceType, data.ResourceId.ValueString(), resourceType))
+	}
+	return diags
+}
+
+func (r *TypedRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	data := r.kind.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	job := data.restoreJob()
+
+	createTimeout, timeoutDiags := job.Timeouts.Create(ctx, defaultRestoreTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.job.createRestoreJob(ctx, &job, r.layout(), createTimeout)...)
+	if !isKnownString(job.JobId) {
+		return
+	}
+
+	data.setRestoreJob(job)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *TypedRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	data := r.kind.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	job := data.restoreJob()
+
+	if job.JobId.IsNull() || job.JobId.ValueString() == "" {
+		return
+	}
+
+	readTimeout, timeoutDiags := job.Timeouts.Read(ctx, defaultRestoreTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.job.refreshRestoreJob(ctx, &job, readTimeout)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.setRestoreJob(job)
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+}
+
+func (r *TypedRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	plan := r.kind.newModel()
+	state := r.kind.newModel()
+
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	job := plan.restoreJob()
+	keepRestoreJobResult(&job, state.restoreJob())
+	plan.setRestoreJob(job)
+
+	// Configuration now supplies the inputs that import couldn't read
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedRestoreJobKey, nil)...)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *TypedRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	data := r.kind.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	job := data.restoreJob()
+
+	deleteTimeout, timeoutDiags := job.Timeouts.Delete(ctx, defaultTimeout)
+	resp.Diagnostics.Append(timeoutDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.job.warnRunningRestoreJob(ctx, job)...)
+}
+
+// ModifyPlan validates new restores against the snapshot, inventory resource and restore account they use
+func (r *TypedRestoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Only restores that are about to start are checked. Existing restores have already run, so in-place updates
+	// shouldn't fail because their snapshot has since expired or their restore account was disconnected, and
+	// destroys have nothing to check. Terraform plans replacements again with a null prior state, so restores that
+	// are being replaced are still checked.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	data := r.kind.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.job.validateRestorePlan(ctx, data.restoreJob(), req.Config, &resp.Plan, r.layout())...)
+}
+
+// MoveState moves eon_restore_job state that uses the kind's configuration block to this resource type. Terraform
+// asks the target resource type to move state, so the type-specific resources implement it for eon_restore_job.
+func (r *TypedRestoreResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !strings.HasSuffix(req.SourceProviderAddress, "eon-io/eon") || !strings.HasSuffix(req.SourceTypeName, "_restore_job") {
+					return
+				}
+
+				job, diags := restoreJobFromRawState(ctx, req.SourceSchemaVersion, req.SourceRawState)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				if !setRestoreConfigBlocks(job)[r.kind.configBlock] {
+					resp.Diagnostics.AddError(
+						"Unable to Move Resource State",
+						fmt.Sprintf("Restore job %s doesn't use %s, so it can't move to eon%s. Move it to the resource type that matches its configuration block.", job.Id.ValueString(), r.kind.configBlock, r.kind.typeNameSuffix),
+					)
+					return
+				}
+
+				data := r.kind.newModel()
+				data.setRestoreJob(job)
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, data)...)
+			},
+		},
+	}
+}
+
+// restoreJobFromRawState decodes eon_restore_job state of any schema version
+func restoreJobFromRawState(ctx context.Context, schemaVersion int64, rawState *tfprotov6.RawState) (RestoreJobResourceModel, diag.Diagnostics) {
+	var job RestoreJobResourceModel
+	var diags diag.Diagnostics
+
+	if rawState == nil || rawState.JSON == nil {
+		diags.AddError("Unable to Move Resource State", "The restore job state is missing.")
+		return job, diags
+	}
+
+	jobSchema := restoreJobSchema(ctx)
+	rawJSON := rawState.JSON
+	switch {
+	case schemaVersion == 0:
+		upgraded, err := upgradeRestoreJobStateV0(rawJSON)
+		if err != nil {
+			diags.AddError("Unable to Move Resource State", fmt.Sprintf("Unable to upgrade restore job state from schema version 0: %s", err))
+			return job, diags
+		}
+		rawJSON = upgraded
+	case schemaVersion > jobSchema.Version:
+		diags.AddError("Unable to Move Resource State", fmt.Sprintf("Restore job state has schema version %d, which is newer than this provider supports.", schemaVersion))
+		return job, diags
+	}
+
+	value, err := tfprotov6.RawState{JSON: rawJSON}.UnmarshalWithOpts(jobSchema.Type().TerraformType(ctx), tfprotov6.UnmarshalOpts{
+		ValueFromJSONOpts: tftypes.ValueFromJSONOpts{IgnoreUndefinedAttributes: true},
+	})
+	if err != nil {
+		diags.AddError("Unable to Move Resource State", fmt.Sprintf("Unable to read restore job state: %s", err))
+		return job, diags
+	}
+
+	state := tfsdk.State{Schema: jobSchema, Raw: value}
+	diags.Append(state.Get(ctx, &job)...)
+	return job, diags
+}
+
+// ImportState rebuilds the restore inputs the Eon API reports for the job, the same way eon_restore_job does. Only
+// jobs that use the kind's configuration block can be imported.
+func (r *TypedRestoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	job, err := r.job.client.GetRestoreJob(ctx, req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restore job %s: %s", req.ID, err))
+		return
+	}
+
+	if restoreTypeAndBlock, ok := importedRestoreConfigBlocks[job.GetRestoreType()]; ok && restoreTypeAndBlock[1] != r.kind.configBlock {
+		resp.Diagnostics.AddError(
+			"Unsupported Restore Job",
+			fmt.Sprintf("Restore job %s is a %s job, so it can't be imported as eon%s. Import it to the resource type that matches its restore type.", req.ID, job.GetRestoreType(), r.kind.typeNameSuffix),
+		)
+		return
+	}
+
+	data, diags := r.importedRestoreModel(ctx, req.ID, job)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedRestoreJobKey, []byte("true"))...)
+}
+
+// importedRestoreModel builds the kind's model from the job's details, going through eon_restore_job state so the
+// configuration attributes import can't read are null
+func (r *TypedRestoreResource) importedRestoreModel(ctx context.Context, jobId string, job *externalEonSdkAPI.RestoreJob) (restoreModel, diag.Diagnostics) {
+	jobSchema := restoreJobSchema(ctx)
+	jobState := tfsdk.State{
+		Schema: jobSchema,
+		Raw:    tftypes.NewValue(jobSchema.Type().TerraformType(ctx), nil),
+	}
+	diags := r.job.setImportedRestoreJobState(ctx, &jobState, jobId, job)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var jobData RestoreJobResourceModel
+	diags.Append(jobState.Get(ctx, &jobData)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	data := r.kind.newModel()
+	data.setRestoreJob(jobData)
+	return data, diags
+}
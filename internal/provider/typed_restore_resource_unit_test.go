@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	externalEonSdkAPI "github.com/eon-io/eon-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestTypedRestoreResourceSchemas tests that every type-specific restore resource has a valid schema whose inputs
+// require replacement
+func TestTypedRestoreResourceSchemas(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		newFunc    func() resource.Resource
+		typeName   string
+		present    []string
+		notPresent []string
+	}{
+		{"ebs", NewEbsVolumeRestoreResource, "eon_ebs_volume_restore", []string{"provider_volume_id", "volume_size", "restored_volume_id"}, []string{"restore_type", "ebs_config", "timeout_minutes"}},
+		{"ec2", NewEc2InstanceRestoreResource, "eon_ec2_instance_restore", []string{"instance_type", "restored_instance_id"}, []string{"restored_volume_id"}},
+		{"rds", NewRdsInstanceRestoreResource, "eon_rds_instance_restore", []string{"db_instance_identifier", "kms_key_id"}, []string{"db_instance_class", "allocated_storage"}},
+		{"s3 bucket", NewS3BucketRestoreResource, "eon_s3_bucket_restore", []string{"bucket_name", "key_prefix"}, nil},
+		{"s3 files", NewS3FilesRestoreResource, "eon_s3_files_restore", []string{"bucket_name"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			r := tt.newFunc()
+
+			metadataResp := &resource.MetadataResponse{}
+			r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "eon"}, metadataResp)
+			assert.Equal(t, tt.typeName, metadataResp.TypeName)
+
+			resp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, resp)
+			require.False(t, resp.Diagnostics.HasError())
+			assert.False(t, resp.Schema.ValidateImplementation(ctx).HasError())
+
+			for _, name := range tt.present {
+				assert.Contains(t, resp.Schema.Attributes, name)
+			}
+			for _, name := range tt.notPresent {
+				assert.NotContains(t, resp.Schema.Attributes, name)
+				assert.NotContains(t, resp.Schema.Blocks, name)
+			}
+
+			for name, attribute := range resp.Schema.Attributes {
+				if name == "wait_for_completion" || !(attribute.IsRequired() || attribute.IsOptional()) {
+					continue
+				}
+				assert.True(t, attributeRequiresReplace(ctx, attribute), "attribute %s should require replacement", name)
+			}
+		})
+	}
+}
+
+// attributeRequiresReplace reports whether an attribute has a plan modifier that requires replacement
+func attributeRequiresReplace(ctx context.Context, attribute schema.Attribute) bool {
+	var descriptions []string
+	switch a := attribute.(type) {
+	case schema.StringAttribute:
+		for _, modifier := range a.PlanModifiers {
+			descriptions = append(descriptions, modifier.Description(ctx))
+		}
+	case schema.Int64Attribute:
+		for _, modifier := range a.PlanModifiers {
+			descriptions = append(descriptions, modifier.Description(ctx))
+		}
+	case schema.BoolAttribute:
+		for _, modifier := range a.PlanModifiers {
+			descriptions = append(descriptions, modifier.Description(ctx))
+		}
+	case schema.ListAttribute:
+		for _, modifier := range a.PlanModifiers {
+			descriptions = append(descriptions, modifier.Description(ctx))
+		}
+	case schema.MapAttribute:
+		for _, modifier := range a.PlanModifiers {
+			descriptions = append(descriptions, modifier.Description(ctx))
+		}
+	}
+
+	for _, description := range descriptions {
+		if strings.Contains(description, "destroy and recreate") {
+			return true
+		}
+	}
+	return false
+}
+
+// moveRestoreJobState runs a type-specific restore resource's state mover on eon_restore_job state
+func moveRestoreJobState(t *testing.T, r resource.Resource, schemaVersion int64, rawState string) (*resource.MoveStateResponse, tfsdk.State) {
+	t.Helper()
+
+	ctx := context.Background()
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+
+	resp := &resource.MoveStateResponse{
+		TargetState: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+	}
+	movers := r.(resource.ResourceWithMoveState).MoveState(ctx)
+	require.Len(t, movers, 1)
+	movers[0].StateMover(ctx, resource.MoveStateRequest{
+		SourceProviderAddress: "registry.terraform.io/eon-io/eon",
+		SourceTypeName:        "eon_restore_job",
+		SourceSchemaVersion:   schemaVersion,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+	}, resp)
+	return resp, resp.TargetState
+}
+
+// TestTypedRestoreResourceMoveState tests moving eon_restore_job state to the type-specific restore resources
+func TestTypedRestoreResourceMoveState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("ebs from schema version 0", func(t *testing.T) {
+		t.Parallel()
+
+		resp, state := moveRestoreJobState(t, NewEbsVolumeRestoreResource(), 0, `{
+			"id": "job-1",
+			"job_id": "job-1",
+			"restore_type": "partial",
+			"snapshot_id": "snap-1",
+			"restore_account_id": "account-1",
+			"timeout_minutes": 60,
+			"wait_for_completion": true,
+			"status": "JOB_COMPLETED",
+			"restored_volume_id": "vol-restored",
+			"ebs_config": {
+				"provider_volume_id": "vol-1",
+				"availability_zone": "us-east-1a",
+				"volume_type": "gp3",
+				"volume_size": 107374182400
+			}
+		}`)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var data EbsVolumeRestoreResourceModel
+		require.False(t, state.Get(ctx, &data).HasError())
+		assert.Equal(t, "job-1", data.JobId.ValueString())
+		assert.Equal(t, "snap-1", data.SnapshotId.ValueString())
+		assert.Equal(t, "vol-1", data.ProviderVolumeId.ValueString())
+		assert.Equal(t, "100GiB", data.VolumeSize.ValueString())
+		assert.Equal(t, "vol-restored", data.RestoredVolumeId.ValueString())
+	})
+
+	t.Run("rds drops unsupported attributes", func(t *testing.T) {
+		t.Parallel()
+
+		resp, state := moveRestoreJobState(t, NewRdsInstanceRestoreResource(), 1, `{
+			"id": "job-2",
+			"restore_type": "full",
+			"rds_config": {
+				"db_instance_identifier": "restored-db",
+				"db_instance_class": "db.t3.micro",
+				"region": "us-east-1",
+				"kms_key_id": "alias/aws/rds"
+			}
+		}`)
+		require.False(t, resp.Diagnostics.HasError(), "%v", resp.Diagnostics)
+
+		var data RdsInstanceRestoreResourceModel
+		require.False(t, state.Get(ctx, &data).HasError())
+		assert.Equal(t, "restored-db", data.DbInstanceIdentifier.ValueString())
+		assert.Equal(t, "alias/aws/rds", data.KmsKeyId.ValueString())
+	})
+
+	t.Run("mismatched block", func(t *testing.T) {
+		t.Parallel()
+
+		resp, state := moveRestoreJobState(t, NewEbsVolumeRestoreResource(), 1, `{
+			"id": "job-3",
+			"restore_type": "full",
+			"s3_bucket_config": {"bucket_name": "my-bucket"}
+		}`)
+		require.True(t, resp.Diagnostics.HasError())
+		assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "doesn't use ebs_config")
+		assert.True(t, state.Raw.IsNull())
+	})
+
+	t.Run("other resource types are ignored", func(t *testing.T) {
+		t.Parallel()
+
+		r := NewS3BucketRestoreResource()
+		resp := &resource.MoveStateResponse{}
+		r.(resource.ResourceWithMoveState).MoveState(ctx)[0].StateMover(ctx, resource.MoveStateRequest{
+			SourceProviderAddress: "registry.terraform.io/hashicorp/aws",
+			SourceTypeName:        "aws_s3_bucket",
+			SourceRawState:        &tfprotov6.RawState{JSON: []byte(`{"id": "my-bucket"}`)},
+		}, resp)
+		assert.False(t, resp.Diagnostics.HasError())
+		assert.True(t, resp.TargetState.Raw.IsNull())
+	})
+}
+
+// TestRestoreKindValidateResourceType tests rejecting snapshots of resources a restore resource can't restore
+func TestRestoreKindValidateResourceType(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, ebsVolumeRestoreKind.validateResourceType(RestoreJobResourceModel{}, externalEonSdkAPI.AWS_EC2).HasError())
+
+	diags := rdsInstanceRestoreKind.validateResourceType(RestoreJobResourceModel{}, externalEonSdkAPI.AWS_S3)
+	require.True(t, diags.HasError())
+	assert.Contains(t, diags.Errors()[0].Detail(), "eon_rds_instance_restore can only restore AWS_RDS resources")
+}
+
+// TestTypedRestoreModelRoundTrip tests converting type-specific restore models to eon_restore_job models and back
+func TestTypedRestoreModelRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ebs := &EbsVolumeRestoreResourceModel{}
+	ebs.ProviderVolumeId = types.StringValue("vol-1")
+	ebs.VolumeSize = NewSizeValue("20GiB")
+
+	job := ebs.restoreJob()
+	assert.Equal(t, "partial", job.RestoreType.ValueString())
+	require.NotNil(t, job.EbsConfig)
+	for block, set := range setRestoreConfigBlocks(job) {
+		assert.Equal(t, block == "ebs_config", set, block)
+	}
+
+	var moved EbsVolumeRestoreResourceModel
+	moved.setRestoreJob(job)
+	assert.Equal(t, "vol-1", moved.ProviderVolumeId.ValueString())
+	assert.Equal(t, "20GiB", moved.VolumeSize.ValueString())
+
+	rds := &RdsInstanceRestoreResourceModel{DbInstanceIdentifier: types.StringValue("db")}
+	rdsJob := rds.restoreJob()
+	assert.Equal(t, "full", rdsJob.RestoreType.ValueString())
+	assert.Empty(t, unsupportedRdsRestoreAttributes(rdsJob.RdsConfig))
+}
+
+// TestTypedRestoreImportedModel tests rebuilding a type-specific restore's inputs from the job's details on import
+func TestTypedRestoreImportedModel(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	r := NewRdsInstanceRestoreResource().(*TypedRestoreResource)
+
+	snapshotId := "snap-1"
+	destination := externalEonSdkAPI.NewDestinationDetails("restore-account", "123456789012", externalEonSdkAPI.AWS, "us-west-2")
+	data, diags := r.importedRestoreModel(ctx, "job-1", &externalEonSdkAPI.RestoreJob{
+		JobExecutionDetails: externalEonSdkAPI.JobExecutionDetails{Status: externalEonSdkAPI.JOB_COMPLETED},
+		SnapshotDetails:     *externalEonSdkAPI.NewNullableJobSnapshotDetails(&externalEonSdkAPI.JobSnapshotDetails{Id: &snapshotId}),
+		ResourceDetails:     *externalEonSdkAPI.NewNullableResourceDetails(&externalEonSdkAPI.ResourceDetails{Id: "resource-1"}),
+		DestinationDetails:  *destination,
+		RestoreType:         externalEonSdkAPI.AWS_RDS_INSTANCE_RESTORE,
+	})
+	require.False(t, diags.HasError(), "%v", diags)
+
+	rds := data.(*RdsInstanceRestoreResourceModel)
+	assert.Equal(t, "snap-1", rds.SnapshotId.ValueString())
+	assert.Equal(t, "resource-1", rds.ResourceId.ValueString())
+	assert.Equal(t, "restore-account", rds.RestoreAccountId.ValueString())
+	assert.Equal(t, "us-west-2", rds.Region.ValueString())
+	assert.True(t, rds.DbInstanceIdentifier.IsNull())
+	assert.True(t, rds.VpcSecurityGroupIds.IsNull())
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.False(t, schemaResp.Diagnostics.HasError())
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+	assert.False(t, state.Set(ctx, data).HasError())
+}
+
+// testPrivateState is private state holding fixed keys
+type testPrivateState map[string][]byte
+
+func (p testPrivateState) GetKey(ctx context.Context, key string) ([]byte, diag.Diagnostics) {
+	return p[key], nil
+}
+
+// TestRestoreInputChanged tests that imported restores adopt configured inputs import couldn't read
+func TestRestoreInputChanged(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	imported := testPrivateState{importedRestoreJobKey: []byte("true")}
+
+	tests := []struct {
+		name     string
+		private  privateState
+		state    attr.Value
+		expected bool
+	}{
+		{"created", testPrivateState{}, types.Int64Null(), true},
+		{"imported and unknown", imported, types.Int64Null(), false},
+		{"imported and known", imported, types.StringValue("us-west-2"), true},
+		{"imported list block", imported, types.ListNull(types.StringType), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			changed, diags := restoreInputChanged(ctx, tt.private, tt.state)
+			require.False(t, diags.HasError())
+			assert.Equal(t, tt.expected, changed)
+		})
+	}
+}
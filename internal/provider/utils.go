@@ -3,8 +3,16 @@ package provider
 import (
 	"fmt"
 	"math"
+	"time"
 )
 
+// defaultTimeout applies to resource operations that have no value in the timeouts block
+const defaultTimeout = 20 * time.Minute
+
+// defaultRestoreTimeout is how long restore resources wait for restore jobs when the timeouts block has no value,
+// matching the eon_restore_job timeout_minutes default
+const defaultRestoreTimeout = 60 * time.Minute
+
 // SafeInt32Conversion performs bounds checking for int64 to int32 conversion
 func SafeInt32Conversion(value int64) (int32, error) {
 	if value < math.MinInt32 || value > math.MaxInt32 {